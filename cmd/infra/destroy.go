@@ -1,21 +1,53 @@
 package infra
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/hypershift/cmd/infra/aws"
 	"github.com/openshift/hypershift/cmd/infra/azure"
+	"github.com/openshift/hypershift/cmd/infra/gcp"
+	"github.com/openshift/hypershift/cmd/infra/powervs"
+	"github.com/openshift/hypershift/pkg/infra/spec"
 )
 
 func NewDestroyCommand() *cobra.Command {
+	var fromFile string
+
 	cmd := &cobra.Command{
 		Use:          "infra",
 		Short:        "Commands for destroying HyperShift infra resources",
 		SilenceUsage: true,
 	}
 
+	cmd.PersistentFlags().Bool("dry-run", false, "Enumerate the resources that would be destroyed without deleting anything")
+	cmd.PersistentFlags().String("output", "table", "Output format for --dry-run: json, yaml, or table")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "Path to a declarative InfraSpec file describing the infra to destroy, as an alternative to per-provider flags")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if fromFile == "" {
+			return cmd.Help()
+		}
+
+		infraSpec, err := spec.Load(fromFile)
+		if err != nil {
+			return err
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		output, _ := cmd.Flags().GetString("output")
+		destroyer, err := spec.NewDestroyer(infraSpec, dryRun, output)
+		if err != nil {
+			return fmt.Errorf("failed to build destroyer from %s: %w", fromFile, err)
+		}
+		return destroyer.Run(cmd.Context())
+	}
+
 	cmd.AddCommand(aws.NewDestroyCommand())
 	cmd.AddCommand(azure.NewDestroyCommand())
+	cmd.AddCommand(gcp.NewDestroyCommand())
+	cmd.AddCommand(powervs.NewDestroyCommand())
+	cmd.AddCommand(NewScanCommand())
 
 	return cmd
 }
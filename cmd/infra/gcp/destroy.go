@@ -0,0 +1,268 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	compute "google.golang.org/api/compute/v1"
+	dns "google.golang.org/api/dns/v1"
+	iam "google.golang.org/api/iam/v1"
+)
+
+// DestroyInfraOptions are the user-facing options for tearing down the GCP
+// infrastructure created by `hypershift create infra gcp`.
+type DestroyInfraOptions struct {
+	Project         string
+	Region          string
+	InfraID         string
+	CredentialsFile string
+	BaseDomain      string
+	Name            string
+	DryRun          bool
+	Output          string
+	Log             *zap.SugaredLogger
+}
+
+// plannedResource is one entry in a --dry-run report: a resource that would
+// be deleted by a real run, without the API call that would delete it.
+type plannedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+func NewDestroyCommand() *cobra.Command {
+	opts := &DestroyInfraOptions{}
+
+	cmd := &cobra.Command{
+		Use:          "gcp",
+		Short:        "Destroys GCP infrastructure resources for a cluster",
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&opts.Project, "gcp-project", opts.Project, "The GCP project the cluster infra lives in")
+	cmd.Flags().StringVar(&opts.Region, "region", opts.Region, "Region where the cluster infra lives")
+	cmd.Flags().StringVar(&opts.InfraID, "infra-id", opts.InfraID, "Cluster specific unique infra ID")
+	cmd.Flags().StringVar(&opts.CredentialsFile, "gcp-creds", opts.CredentialsFile, "Path to GCP service account credentials JSON")
+	cmd.Flags().StringVar(&opts.BaseDomain, "base-domain", opts.BaseDomain, "The ingress base domain for the cluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "Cluster name")
+
+	cmd.MarkFlagRequired("infra-id")
+	cmd.MarkFlagRequired("gcp-creds")
+	cmd.MarkFlagRequired("region")
+
+	logger, _ := zap.NewProduction(zap.AddCaller())
+	opts.Log = logger.Sugar()
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
+		opts.Output, _ = cmd.Flags().GetString("output")
+		if err := opts.Run(cmd.Context()); err != nil {
+			opts.Log.Error(err)
+			return err
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+// Run tears down, in order, the DNS records, subnet, VPC network, and service
+// accounts created for the cluster's infra ID. When
+// DryRun is set, no mutating API calls are made and the plan is printed in
+// the requested Output format instead.
+func (o *DestroyInfraOptions) Run(ctx context.Context) error {
+	var computeClient *compute.Service
+	var dnsClient *dns.Service
+	var iamClient *iam.Service
+	if !o.DryRun {
+		var err error
+		computeClient, err = compute.NewService(ctx, option.WithCredentialsFile(o.CredentialsFile))
+		if err != nil {
+			return fmt.Errorf("failed to create GCP compute client: %w", err)
+		}
+		dnsClient, err = dns.NewService(ctx, option.WithCredentialsFile(o.CredentialsFile))
+		if err != nil {
+			return fmt.Errorf("failed to create GCP DNS client: %w", err)
+		}
+		iamClient, err = iam.NewService(ctx, option.WithCredentialsFile(o.CredentialsFile))
+		if err != nil {
+			return fmt.Errorf("failed to create GCP IAM client: %w", err)
+		}
+	}
+
+	var plan []plannedResource
+
+	dnsPlan, err := o.DestroyDNS(ctx, dnsClient)
+	if err != nil {
+		return fmt.Errorf("failed to destroy DNS records: %w", err)
+	}
+	plan = append(plan, dnsPlan...)
+
+	subnetPlan, err := o.DestroySubnets(ctx, computeClient)
+	if err != nil {
+		return fmt.Errorf("failed to destroy subnets: %w", err)
+	}
+	plan = append(plan, subnetPlan...)
+
+	vpcPlan, err := o.DestroyVPC(ctx, computeClient)
+	if err != nil {
+		return fmt.Errorf("failed to destroy VPC: %w", err)
+	}
+	plan = append(plan, vpcPlan...)
+
+	saPlan, err := o.DestroyServiceAccounts(ctx, iamClient)
+	if err != nil {
+		return fmt.Errorf("failed to destroy service accounts: %w", err)
+	}
+	plan = append(plan, saPlan...)
+
+	if o.DryRun {
+		return printPlan(plan, o.Output)
+	}
+
+	o.Log.Infow("Successfully destroyed GCP infrastructure", "infraID", o.InfraID)
+	return nil
+}
+
+func (o *DestroyInfraOptions) DestroyDNS(ctx context.Context, client *dns.Service) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s DNS records under %s", o.InfraID, o.BaseDomain)
+	if o.DryRun {
+		return []plannedResource{{Kind: "DNSRecordSet", Name: name}}, nil
+	}
+
+	zone, err := o.findManagedZone(ctx, client)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if zone == nil {
+		o.Log.Infow("No managed zone found for base domain, nothing to destroy", "baseDomain", o.BaseDomain)
+		return nil, nil
+	}
+
+	recordSets, err := client.ResourceRecordSets.List(o.Project, zone.Name).Context(ctx).Do()
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	prefix := o.InfraID + "."
+	var deletions []*dns.ResourceRecordSet
+	for _, rrset := range recordSets.Rrsets {
+		if strings.HasPrefix(rrset.Name, prefix) {
+			deletions = append(deletions, rrset)
+		}
+	}
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+
+	o.Log.Infow("Destroying DNS records", "infraID", o.InfraID, "baseDomain", o.BaseDomain, "count", len(deletions))
+	_, err = client.Changes.Create(o.Project, zone.Name, &dns.Change{Deletions: deletions}).Context(ctx).Do()
+	return nil, ignoreNotFound(err)
+}
+
+// findManagedZone returns the managed zone whose DNSName matches BaseDomain,
+// or nil if no such zone exists in the project.
+func (o *DestroyInfraOptions) findManagedZone(ctx context.Context, client *dns.Service) (*dns.ManagedZone, error) {
+	zones, err := client.ManagedZones.List(o.Project).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	target := o.BaseDomain + "."
+	for _, zone := range zones.ManagedZones {
+		if zone.DnsName == target {
+			return zone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (o *DestroyInfraOptions) DestroySubnets(ctx context.Context, client *compute.Service) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-subnet", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "Subnetwork", Name: name}}, nil
+	}
+	o.Log.Infow("Destroying subnet", "name", name)
+	_, err := client.Subnetworks.Delete(o.Project, o.Region, name).Context(ctx).Do()
+	return nil, ignoreNotFound(err)
+}
+
+func (o *DestroyInfraOptions) DestroyVPC(ctx context.Context, client *compute.Service) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-vpc", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "Network", Name: name}}, nil
+	}
+	o.Log.Infow("Destroying VPC network", "name", name)
+	_, err := client.Networks.Delete(o.Project, name).Context(ctx).Do()
+	return nil, ignoreNotFound(err)
+}
+
+func (o *DestroyInfraOptions) DestroyServiceAccounts(ctx context.Context, client *iam.Service) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s service accounts", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "ServiceAccount", Name: name}}, nil
+	}
+
+	accounts, err := client.Projects.ServiceAccounts.List(fmt.Sprintf("projects/%s", o.Project)).Context(ctx).Do()
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	prefix := o.InfraID + "-"
+	for _, sa := range accounts.Accounts {
+		localID := strings.SplitN(sa.Email, "@", 2)[0]
+		if !strings.HasPrefix(localID, prefix) {
+			continue
+		}
+		o.Log.Infow("Destroying service account", "email", sa.Email)
+		if _, err := client.Projects.ServiceAccounts.Delete(sa.Name).Context(ctx).Do(); ignoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// ignoreNotFound swallows a 404 from the GCP APIs, since a resource that is
+// already gone (e.g. a partial prior destroy, or manual cleanup) is not a
+// failure for destroy to report.
+func ignoreNotFound(err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func printPlan(plan []plannedResource, output string) error {
+	switch output {
+	case "json":
+		out, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	case "yaml":
+		out, err := yaml.Marshal(plan)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
+	default:
+		fmt.Printf("%-20s %s\n", "KIND", "NAME")
+		for _, r := range plan {
+			fmt.Printf("%-20s %s\n", r.Kind, r.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package powervs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+)
+
+// DestroyInfraOptions are the user-facing options for tearing down the IBM
+// PowerVS infrastructure created by `hypershift create infra powervs`.
+type DestroyInfraOptions struct {
+	InfraID         string
+	Region          string
+	Zone            string
+	ResourceGroup   string
+	CloudInstanceID string
+	CISCRN          string
+	BaseDomain      string
+	Name            string
+	CredentialsFile string
+	DryRun          bool
+	Output          string
+	Log             *zap.SugaredLogger
+}
+
+// plannedResource is one entry in a --dry-run report: a resource that would
+// be deleted by a real run, without the API call that would delete it.
+type plannedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+func NewDestroyCommand() *cobra.Command {
+	opts := &DestroyInfraOptions{}
+
+	cmd := &cobra.Command{
+		Use:          "powervs",
+		Short:        "Destroys PowerVS infrastructure resources for a cluster",
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&opts.InfraID, "infra-id", opts.InfraID, "Cluster specific unique infra ID")
+	cmd.Flags().StringVar(&opts.Region, "region", opts.Region, "IBM Cloud region")
+	cmd.Flags().StringVar(&opts.Zone, "zone", opts.Zone, "IBM Cloud zone")
+	cmd.Flags().StringVar(&opts.ResourceGroup, "resource-group", opts.ResourceGroup, "IBM Cloud resource group")
+	cmd.Flags().StringVar(&opts.CloudInstanceID, "cloud-instance-id", opts.CloudInstanceID, "PowerVS cloud instance ID")
+	cmd.Flags().StringVar(&opts.CISCRN, "cis-crn", opts.CISCRN, "CRN of the CIS instance hosting the base domain")
+	cmd.Flags().StringVar(&opts.BaseDomain, "base-domain", opts.BaseDomain, "The ingress base domain for the cluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "Cluster name")
+	cmd.Flags().StringVar(&opts.CredentialsFile, "ibmcloud-creds", opts.CredentialsFile, "Path to IBM Cloud credentials file")
+
+	cmd.MarkFlagRequired("infra-id")
+	cmd.MarkFlagRequired("cloud-instance-id")
+	cmd.MarkFlagRequired("ibmcloud-creds")
+
+	logger, _ := zap.NewProduction(zap.AddCaller())
+	opts.Log = logger.Sugar()
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
+		opts.Output, _ = cmd.Flags().GetString("output")
+		if err := opts.Run(cmd.Context()); err != nil {
+			opts.Log.Error(err)
+			return err
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+// Run walks, in order, the DNS records, cloud connection, DHCP server,
+// network, SSH key, and workspace of the cluster's infra ID. When DryRun is
+// set, the plan is printed in the requested Output format. Without DryRun,
+// it makes no IBM Cloud API call and deletes nothing: the first step returns
+// errPowerVSDestroyNotImplemented.
+func (o *DestroyInfraOptions) Run(ctx context.Context) error {
+	var plan []plannedResource
+
+	dnsPlan, err := o.DestroyDNSRecords(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy DNS records: %w", err)
+	}
+	plan = append(plan, dnsPlan...)
+
+	ccPlan, err := o.DestroyCloudConnection(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy cloud connection: %w", err)
+	}
+	plan = append(plan, ccPlan...)
+
+	dhcpPlan, err := o.DestroyDHCPServer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy DHCP server: %w", err)
+	}
+	plan = append(plan, dhcpPlan...)
+
+	netPlan, err := o.DestroyNetwork(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy network: %w", err)
+	}
+	plan = append(plan, netPlan...)
+
+	sshPlan, err := o.DestroySSHKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy SSH key: %w", err)
+	}
+	plan = append(plan, sshPlan...)
+
+	wsPlan, err := o.DestroyWorkspace(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to destroy workspace: %w", err)
+	}
+	plan = append(plan, wsPlan...)
+
+	if o.DryRun {
+		return printPlan(plan, o.Output)
+	}
+
+	o.Log.Infow("Successfully destroyed PowerVS infrastructure", "infraID", o.InfraID)
+	return nil
+}
+
+// errPowerVSDestroyNotImplemented is returned by every real (non-dry-run)
+// PowerVS teardown call below: none of them make an IBM Cloud API call yet,
+// so refusing is safer than logging success and leaving the resource behind.
+// Run with --dry-run to see what would be destroyed.
+var errPowerVSDestroyNotImplemented = fmt.Errorf("destroying PowerVS infrastructure is not yet implemented; rerun with --dry-run to see what would be destroyed")
+
+func (o *DestroyInfraOptions) DestroyDNSRecords(ctx context.Context) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s DNS records under %s", o.InfraID, o.BaseDomain)
+	if o.DryRun {
+		return []plannedResource{{Kind: "DNSRecord", Name: name}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func (o *DestroyInfraOptions) DestroyCloudConnection(ctx context.Context) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-cloud-connection", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "CloudConnection", Name: name}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func (o *DestroyInfraOptions) DestroyDHCPServer(ctx context.Context) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-dhcp", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "DHCPServer", Name: name}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func (o *DestroyInfraOptions) DestroyNetwork(ctx context.Context) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-network", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "Network", Name: name}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func (o *DestroyInfraOptions) DestroySSHKey(ctx context.Context) ([]plannedResource, error) {
+	name := fmt.Sprintf("%s-ssh-key", o.InfraID)
+	if o.DryRun {
+		return []plannedResource{{Kind: "SSHKey", Name: name}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func (o *DestroyInfraOptions) DestroyWorkspace(ctx context.Context) ([]plannedResource, error) {
+	if o.DryRun {
+		return []plannedResource{{Kind: "Workspace", Name: o.CloudInstanceID}}, nil
+	}
+	return nil, errPowerVSDestroyNotImplemented
+}
+
+func printPlan(plan []plannedResource, output string) error {
+	switch output {
+	case "json":
+		out, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	case "yaml":
+		out, err := yaml.Marshal(plan)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
+	default:
+		fmt.Printf("%-20s %s\n", "KIND", "NAME")
+		for _, r := range plan {
+			fmt.Printf("%-20s %s\n", r.Kind, r.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	armresourcegraph "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resourcegraph/armresourcegraph"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+
+	hyperv1 "github.com/openshift/hypershift/api/v1alpha1"
+	"github.com/openshift/hypershift/cmd/infra/aws"
+	"github.com/openshift/hypershift/cmd/infra/azure"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// hypershiftInfraIDTagKey is the tag/label key whose value is the owning
+// HostedCluster's infra ID. hypershiftOwnershipTags lists every key a
+// provider may use to mark a cloud resource as HyperShift-owned, for
+// reporting purposes.
+const hypershiftInfraIDTagKey = "hypershift.openshift.io/infra-id"
+
+// hypershiftOwnershipTags are the tag/label keys providers use to mark a
+// cloud resource as owned by a HyperShift infra ID.
+var hypershiftOwnershipTags = []string{
+	"kubernetes.io/cluster/",
+	"red-hat-managed",
+	hypershiftInfraIDTagKey,
+}
+
+// OrphanResource is a single cloud resource discovered by scan that carries
+// HyperShift ownership tags but does not correspond to a live HostedCluster.
+type OrphanResource struct {
+	Provider string `json:"provider"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	InfraID  string `json:"infraID"`
+}
+
+// ScanOptions are the user-facing options for `hypershift destroy infra scan`.
+type ScanOptions struct {
+	Providers  []string
+	NamePrefix string
+	Kubeconfig string
+	Output     string
+	Destroy    bool
+	AWSCreds   string
+	AzureCreds string
+	Log        *zap.SugaredLogger
+}
+
+// NewScanCommand walks each supported provider looking for resources tagged
+// with HyperShift ownership labels that no longer correspond to a live
+// HostedCluster in the target management cluster, so create failures that
+// leave orphaned VPCs/NAT gateways/managed identities can be found and
+// reaped instead of silently accumulating cost.
+func NewScanCommand() *cobra.Command {
+	opts := &ScanOptions{
+		Providers: []string{"aws", "azure"},
+		Output:    "table",
+	}
+
+	cmd := &cobra.Command{
+		Use:          "scan",
+		Short:        "Find orphaned cloud infra resources left behind by failed or partial creates",
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Providers, "providers", opts.Providers, "Providers to scan")
+	cmd.Flags().StringVar(&opts.NamePrefix, "name-prefix", opts.NamePrefix, "Only consider resources whose infra ID has this prefix")
+	cmd.Flags().StringVar(&opts.Kubeconfig, "kubeconfig", opts.Kubeconfig, "Kubeconfig of the management cluster used to determine which infra IDs are still live")
+	cmd.Flags().StringVar(&opts.Output, "output", opts.Output, "Output format for the report: json, yaml, or table")
+	cmd.Flags().BoolVar(&opts.Destroy, "destroy", opts.Destroy, "Destroy discovered orphans instead of only reporting them")
+	cmd.Flags().StringVar(&opts.AWSCreds, "aws-creds", opts.AWSCreds, "Path to AWS credentials file")
+	cmd.Flags().StringVar(&opts.AzureCreds, "azure-creds", opts.AzureCreds, "Path to Azure credentials file")
+
+	logger, _ := zap.NewProduction(zap.AddCaller())
+	opts.Log = logger.Sugar()
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := opts.Run(cmd.Context()); err != nil {
+			opts.Log.Error(err)
+			return err
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+// Run lists tagged resources per provider, filters out any whose infra ID
+// still has a live HostedCluster, reports the rest, and optionally destroys
+// them by reusing each provider's existing destroy primitives.
+func (o *ScanOptions) Run(ctx context.Context) error {
+	liveInfraIDs, err := o.liveInfraIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to determine live infra IDs from management cluster: %w", err)
+	}
+
+	var orphans []OrphanResource
+	for _, provider := range o.Providers {
+		found, err := o.scanProvider(ctx, provider)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s: %w", provider, err)
+		}
+		for _, r := range found {
+			if liveInfraIDs[r.InfraID] {
+				continue
+			}
+			orphans = append(orphans, r)
+		}
+	}
+
+	if err := printOrphans(orphans, o.Output); err != nil {
+		return err
+	}
+
+	if !o.Destroy || len(orphans) == 0 {
+		return nil
+	}
+
+	for _, orphan := range dedupOrphansByInfraID(orphans) {
+		o.Log.Infow("Destroying orphaned infra", "provider", orphan.Provider, "infraID", orphan.InfraID)
+		if err := o.destroyOrphan(ctx, orphan); err != nil {
+			return fmt.Errorf("failed to destroy orphaned %s infra %s: %w", orphan.Provider, orphan.InfraID, err)
+		}
+	}
+	return nil
+}
+
+// dedupOrphansByInfraID collapses orphans down to one representative per
+// (provider, infra ID) pair, keeping the first one seen. destroyOrphan tears
+// down a provider's entire infra for an ID in a single call, so destroying
+// once per tagged resource row would repeat that teardown N times and, for
+// providers whose destroy isn't idempotent against already-deleted
+// resources, fail the 2nd+ call and abort the rest of the scan run.
+func dedupOrphansByInfraID(orphans []OrphanResource) []OrphanResource {
+	seen := map[string]bool{}
+	var deduped []OrphanResource
+	for _, orphan := range orphans {
+		key := orphan.Provider + "/" + orphan.InfraID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, orphan)
+	}
+	return deduped
+}
+
+// liveInfraIDs returns the set of infra IDs that still have a HostedCluster
+// in the management cluster pointed to by Kubeconfig. If Kubeconfig is unset,
+// every discovered resource is treated as orphaned, since there is no
+// management cluster to check against.
+func (o *ScanOptions) liveInfraIDs(ctx context.Context) (map[string]bool, error) {
+	live := map[string]bool{}
+	if o.Kubeconfig == "" {
+		return live, nil
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags("", o.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig %s: %w", o.Kubeconfig, err)
+	}
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	var hostedClusters hyperv1.HostedClusterList
+	if err := c.List(ctx, &hostedClusters); err != nil {
+		return nil, err
+	}
+	for _, hc := range hostedClusters.Items {
+		if hc.Spec.InfraID != "" {
+			live[hc.Spec.InfraID] = true
+		}
+	}
+	return live, nil
+}
+
+// scanProvider dispatches to the per-provider resource listing.
+func (o *ScanOptions) scanProvider(ctx context.Context, provider string) ([]OrphanResource, error) {
+	o.Log.Infow("Scanning provider for tagged resources", "provider", provider, "namePrefix", o.NamePrefix, "tags", hypershiftOwnershipTags)
+	switch provider {
+	case "aws":
+		return o.scanAWS(ctx)
+	case "azure":
+		return o.scanAzure(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported provider %q", provider)
+	}
+}
+
+// scanAWS lists every AWS resource tagged with hypershiftInfraIDTagKey via
+// the Resource Groups Tagging API, which covers most resource types HyperShift
+// creates (VPCs, subnets, NAT gateways, IAM roles) without a per-service call.
+func (o *ScanOptions) scanAWS(ctx context.Context) ([]OrphanResource, error) {
+	var optFns []func(*awsconfig.LoadOptions) error
+	if o.AWSCreds != "" {
+		optFns = append(optFns, awsconfig.WithSharedCredentialsFiles([]string{o.AWSCreds}))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS credentials: %w", err)
+	}
+
+	// TagFilters only checks hypershiftInfraIDTagKey, not every entry in
+	// hypershiftOwnershipTags: "kubernetes.io/cluster/" is a key prefix
+	// (the cluster ID is appended, e.g. "kubernetes.io/cluster/<infra-id>"),
+	// which the Resource Groups Tagging API's TagFilters can't express since
+	// it matches whole keys, not prefixes; "red-hat-managed" doesn't carry an
+	// infra ID value, so it can't identify which HostedCluster a resource
+	// belongs to even if matched. hypershiftInfraIDTagKey is the only entry
+	// that is both a literal key and infra-ID-bearing, so it's the only one
+	// usable here; the others remain in hypershiftOwnershipTags for logging.
+	taggingClient := resourcegroupstaggingapi.NewFromConfig(cfg)
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(taggingClient, &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []rgttypes.TagFilter{
+			{Key: derefPtr(hypershiftInfraIDTagKey)},
+		},
+	})
+
+	var orphans []OrphanResource
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tagged AWS resources: %w", err)
+		}
+		for _, resource := range page.ResourceTagMappingList {
+			infraID := tagValue(resource.Tags, hypershiftInfraIDTagKey)
+			if infraID == "" || (o.NamePrefix != "" && !strings.HasPrefix(infraID, o.NamePrefix)) {
+				continue
+			}
+			arn := derefString(resource.ResourceARN)
+			orphans = append(orphans, OrphanResource{
+				Provider: "aws",
+				Kind:     arnResourceType(arn),
+				Name:     arn,
+				InfraID:  infraID,
+			})
+		}
+	}
+	return orphans, nil
+}
+
+// tagValue returns the value of the AWS tag named key, or "" if absent.
+func tagValue(tags []rgttypes.Tag, key string) string {
+	for _, tag := range tags {
+		if derefString(tag.Key) == key {
+			return derefString(tag.Value)
+		}
+	}
+	return ""
+}
+
+// arnResourceType extracts the resource-type portion of an ARN, e.g.
+// "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0123" -> "vpc".
+func arnResourceType(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return "unknown"
+	}
+	resource := parts[5]
+	if idx := strings.IndexAny(resource, "/"); idx >= 0 {
+		return resource[:idx]
+	}
+	return resource
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefPtr(s string) *string {
+	return &s
+}
+
+// azureServicePrincipal is the shape of the JSON file --azure-creds points
+// to: a service principal with Resource Graph read access.
+type azureServicePrincipal struct {
+	SubscriptionID string `json:"subscriptionId"`
+	TenantID       string `json:"tenantId"`
+	ClientID       string `json:"clientId"`
+	ClientSecret   string `json:"clientSecret"`
+}
+
+// scanAzure lists every Azure resource tagged with hypershiftInfraIDTagKey via
+// a Resource Graph query, which spans every resource group of the
+// credentials' subscription in a single call.
+func (o *ScanOptions) scanAzure(ctx context.Context) ([]OrphanResource, error) {
+	if o.AzureCreds == "" {
+		return nil, fmt.Errorf("--azure-creds is required to scan azure")
+	}
+	raw, err := os.ReadFile(o.AzureCreds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read azure credentials file %s: %w", o.AzureCreds, err)
+	}
+	var sp azureServicePrincipal
+	if err := json.Unmarshal(raw, &sp); err != nil {
+		return nil, fmt.Errorf("failed to parse azure credentials file %s: %w", o.AzureCreds, err)
+	}
+
+	cred, err := azidentity.NewClientSecretCredential(sp.TenantID, sp.ClientID, sp.ClientSecret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build azure credential: %w", err)
+	}
+	graphClient, err := armresourcegraph.NewClient(cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build azure resource graph client: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		"Resources | where isnotempty(tags['%s']) | project type, name, infraID = tostring(tags['%s'])",
+		hypershiftInfraIDTagKey, hypershiftInfraIDTagKey,
+	)
+	if o.NamePrefix != "" {
+		query += fmt.Sprintf(" | where infraID startswith '%s'", o.NamePrefix)
+	}
+
+	resp, err := graphClient.Resources(ctx, armresourcegraph.QueryRequest{
+		Subscriptions: []*string{&sp.SubscriptionID},
+		Query:         &query,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query azure resource graph: %w", err)
+	}
+
+	rows, ok := resp.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected azure resource graph response shape")
+	}
+
+	var orphans []OrphanResource
+	for _, row := range rows {
+		fields, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		orphans = append(orphans, OrphanResource{
+			Provider: "azure",
+			Kind:     fmt.Sprintf("%v", fields["type"]),
+			Name:     fmt.Sprintf("%v", fields["name"]),
+			InfraID:  fmt.Sprintf("%v", fields["infraID"]),
+		})
+	}
+	return orphans, nil
+}
+
+// destroyOrphan reuses the provider's own DestroyInfraOptions.Run, the same
+// primitive `hypershift destroy infra <provider>` uses, instead of
+// reimplementing per-resource-kind teardown here.
+func (o *ScanOptions) destroyOrphan(ctx context.Context, orphan OrphanResource) error {
+	switch orphan.Provider {
+	case "aws":
+		return (&aws.DestroyInfraOptions{
+			InfraID:            orphan.InfraID,
+			AWSCredentialsFile: o.AWSCreds,
+			Log:                o.Log,
+		}).Run(ctx)
+	case "azure":
+		return (&azure.DestroyInfraOptions{
+			InfraID:   orphan.InfraID,
+			CredsFile: o.AzureCreds,
+			Log:       o.Log,
+		}).Run(ctx)
+	default:
+		return fmt.Errorf("no destroy primitive for provider %q", orphan.Provider)
+	}
+}
+
+func printOrphans(orphans []OrphanResource, output string) error {
+	switch output {
+	case "json":
+		out, err := json.MarshalIndent(orphans, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	case "yaml":
+		out, err := yaml.Marshal(orphans)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
+	default:
+		fmt.Printf("%-10s %-20s %-30s %s\n", "PROVIDER", "KIND", "NAME", "INFRA ID")
+		for _, r := range orphans {
+			fmt.Printf("%-10s %-20s %-30s %s\n", r.Provider, r.Kind, r.Name, r.InfraID)
+		}
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package assets
 import (
 	"fmt"
 
+	securityv1 "github.com/openshift/api/security/v1"
 	hyperv1 "github.com/openshift/hypershift/api/v1alpha1"
+	"github.com/openshift/hypershift/pkg/oidc/storage"
 	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,10 @@ const (
 type HyperShiftNamespace struct {
 	Name                       string
 	EnableOCPClusterMonitoring bool
+	// EnableRestrictedSCC labels the namespace for Pod Security Admission
+	// enforcement, matching the restricted-v2 posture the operator pod now
+	// runs under when HyperShiftOperatorDeployment.EnableRestrictedSCC is set.
+	EnableRestrictedSCC bool
 }
 
 func (o HyperShiftNamespace) Build() *corev1.Namespace {
@@ -49,6 +55,12 @@ func (o HyperShiftNamespace) Build() *corev1.Namespace {
 			"openshift.io/cluster-monitoring": "true",
 		}
 	}
+	if o.EnableRestrictedSCC {
+		if namespace.Labels == nil {
+			namespace.Labels = map[string]string{}
+		}
+		namespace.Labels["pod-security.kubernetes.io/enforce"] = "restricted"
+	}
 	return namespace
 }
 
@@ -81,6 +93,10 @@ func (o HyperShiftOperatorCredentialsSecret) Build() *corev1.Secret {
 	return secret
 }
 
+// HyperShiftOperatorOIDCProviderS3Secret holds the static S3 credentials used
+// to publish OIDC discovery documents. It is not needed when
+// HyperShiftOperatorDeployment.OIDCStorageProviderS3RoleArn is set, since IRSA
+// lets the operator authenticate without long-lived keys.
 type HyperShiftOperatorOIDCProviderS3Secret struct {
 	Namespace                      *corev1.Namespace
 	OIDCStorageProviderS3CredBytes []byte
@@ -126,17 +142,81 @@ func (o ExternalDNSCredsSecret) Build() *corev1.Secret {
 	return secret
 }
 
+// ExternalDNSProviderConfig carries the tunables for external-dns providers
+// that need more than a credentials secret and a domain filter.
+type ExternalDNSProviderConfig struct {
+	// AzureResourceGroup is passed as --azure-resource-group for the azure
+	// and azure-private-dns providers.
+	AzureResourceGroup string
+	// GCPProject is passed as --google-project for the google provider.
+	GCPProject string
+	// ZoneType is passed as --aws-zone-type for the aws provider (e.g. public, private).
+	ZoneType string
+	// TXTPrefix is passed as --txt-prefix so TXT registry records don't collide
+	// with the records they describe.
+	TXTPrefix string
+	// InfobloxGridHost is passed as --infoblox-grid-host for the infoblox provider.
+	InfobloxGridHost string
+	// AWSRoleArn, when set, switches the aws provider from the mounted
+	// credentials file to IRSA/STS web-identity auth.
+	AWSRoleArn string
+	// AWSSTSAudience defaults to "openshift" when AWSRoleArn is set.
+	AWSSTSAudience string
+}
+
 type ExternalDNSDeployment struct {
 	Namespace         *corev1.Namespace
 	Image             string
 	ServiceAccount    *corev1.ServiceAccount
 	Provider          string
+	ProviderConfig    ExternalDNSProviderConfig
 	DomainFilter      string
 	CredentialsSecret *corev1.Secret
+	// Registry is passed as --registry (e.g. noop, txt). Defaults to noop.
+	Registry string
+	// TXTOwnerID is passed as --txt-owner-id. Defaults to "hypershift".
+	TXTOwnerID string
+	// Replicas defaults to 1 when unset.
+	Replicas int32
 }
 
 func (o ExternalDNSDeployment) Build() *appsv1.Deployment {
-	replicas := int32(1)
+	replicas := o.Replicas
+	if replicas == 0 {
+		replicas = 1
+	}
+	registry := o.Registry
+	if registry == "" {
+		registry = "noop"
+	}
+	txtOwnerID := o.TXTOwnerID
+	if txtOwnerID == "" {
+		txtOwnerID = "hypershift"
+	}
+
+	// cloudflare authenticates with an env var sourced from the credentials
+	// secret rather than a mounted file, so it gets no credentials volume.
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "credentials",
+			MountPath: "/etc/provider",
+		},
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: "credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: o.CredentialsSecret.Name,
+				},
+			},
+		},
+	}
+	if o.Provider == "cloudflare" {
+		volumeMounts = nil
+		volumes = nil
+	}
+
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Deployment",
@@ -174,8 +254,8 @@ func (o ExternalDNSDeployment) Build() *appsv1.Deployment {
 								"--source=openshift-route",
 								fmt.Sprintf("--domain-filter=%s", o.DomainFilter),
 								fmt.Sprintf("--provider=%s", o.Provider),
-								"--registry=noop",
-								"--txt-owner-id=hypershift",
+								fmt.Sprintf("--registry=%s", registry),
+								fmt.Sprintf("--txt-owner-id=%s", txtOwnerID),
 							},
 							Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 7979}},
 							LivenessProbe: &corev1.Probe{
@@ -198,64 +278,225 @@ func (o ExternalDNSDeployment) Build() *appsv1.Deployment {
 									corev1.ResourceCPU:    resource.MustParse("5m"),
 								},
 							},
-							VolumeMounts: []corev1.VolumeMount{
-								{
-									Name:      "credentials",
-									MountPath: "/etc/provider",
-								},
-							},
-						},
-					},
-					Volumes: []corev1.Volume{
-						{
-							Name: "credentials",
-							VolumeSource: corev1.VolumeSource{
-								Secret: &corev1.SecretVolumeSource{
-									SecretName: o.CredentialsSecret.Name,
-								},
-							},
+							VolumeMounts: volumeMounts,
 						},
 					},
+					Volumes: volumes,
 				},
 			},
 		},
 	}
 
+	if o.ProviderConfig.TXTPrefix != "" {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--txt-prefix=%s", o.ProviderConfig.TXTPrefix))
+	}
+
 	// Add platform specific settings
 	switch o.Provider {
 	case "aws":
+		zoneType := o.ProviderConfig.ZoneType
+		if zoneType == "" {
+			zoneType = "public"
+		}
+		if len(o.ProviderConfig.AWSRoleArn) > 0 {
+			stsAudience := o.ProviderConfig.AWSSTSAudience
+			if stsAudience == "" {
+				stsAudience = "openshift"
+			}
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = nil
+			deployment.Spec.Template.Spec.Volumes = nil
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{
+					Name:  "AWS_ROLE_ARN",
+					Value: o.ProviderConfig.AWSRoleArn,
+				},
+				corev1.EnvVar{
+					Name:  "AWS_WEB_IDENTITY_TOKEN_FILE",
+					Value: "/var/run/secrets/openshift/serviceaccount/token",
+				},
+				corev1.EnvVar{
+					Name:  "AWS_REGION",
+					Value: "us-east-1",
+				})
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
+				corev1.VolumeMount{
+					Name:      "token",
+					MountPath: "/var/run/secrets/openshift/serviceaccount",
+				})
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes,
+				corev1.Volume{
+					Name: "token",
+					VolumeSource: corev1.VolumeSource{
+						Projected: &corev1.ProjectedVolumeSource{
+							Sources: []corev1.VolumeProjection{
+								{
+									ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+										Audience: stsAudience,
+										Path:     "token",
+									},
+								},
+							},
+						},
+					},
+				})
+		} else {
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{
+					Name:  "AWS_SHARED_CREDENTIALS_FILE",
+					Value: "/etc/provider/credentials",
+				},
+				corev1.EnvVar{
+					Name:  "AWS_REGION",
+					Value: "us-east-1",
+				})
+		}
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--aws-zone-type=%s", zoneType))
+	case "azure", "azure-private-dns":
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+			{
+				Name:      "credentials",
+				MountPath: "/etc/kubernetes",
+			},
+		}
+		deployment.Spec.Template.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "credentials",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: o.CredentialsSecret.Name,
+						Items: []corev1.KeyToPath{
+							{Key: "credentials", Path: "azure.json"},
+						},
+					},
+				},
+			},
+		}
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args,
+			fmt.Sprintf("--azure-resource-group=%s", o.ProviderConfig.AzureResourceGroup))
+	case "google":
 		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
 			corev1.EnvVar{
-				Name:  "AWS_SHARED_CREDENTIALS_FILE",
+				Name:  "GOOGLE_APPLICATION_CREDENTIALS",
 				Value: "/etc/provider/credentials",
+			})
+		if o.ProviderConfig.GCPProject != "" {
+			deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args,
+				fmt.Sprintf("--google-project=%s", o.ProviderConfig.GCPProject))
+		}
+	case "cloudflare":
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+			corev1.EnvVar{
+				Name: "CF_API_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: o.CredentialsSecret.Name},
+						Key:                  "credentials",
+					},
+				},
+			})
+	case "infoblox":
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+			corev1.EnvVar{
+				Name: "EXTERNAL_DNS_INFOBLOX_WAPI_USERNAME",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: o.CredentialsSecret.Name},
+						Key:                  "username",
+					},
+				},
 			},
 			corev1.EnvVar{
-				Name:  "AWS_REGION",
-				Value: "us-east-1",
+				Name: "EXTERNAL_DNS_INFOBLOX_WAPI_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: o.CredentialsSecret.Name},
+						Key:                  "password",
+					},
+				},
 			})
-		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--aws-zone-type=public")
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args,
+			fmt.Sprintf("--infoblox-grid-host=%s", o.ProviderConfig.InfobloxGridHost))
 	}
 
 	return deployment
 }
 
 type HyperShiftOperatorDeployment struct {
-	Namespace                      *corev1.Namespace
-	OperatorImage                  string
-	ServiceAccount                 *corev1.ServiceAccount
-	Replicas                       int32
-	EnableOCPClusterMonitoring     bool
-	EnableCIDebugOutput            bool
-	PrivatePlatform                string
-	AWSPrivateCreds                string
-	AWSPrivateRegion               string
+	Namespace                  *corev1.Namespace
+	OperatorImage              string
+	ServiceAccount             *corev1.ServiceAccount
+	Replicas                   int32
+	EnableOCPClusterMonitoring bool
+	EnableCIDebugOutput        bool
+	// EnableRestrictedSCC drops the anyuid SCC requirement: the container
+	// gets no explicit RunAsUser (letting OpenShift's namespace UID-range
+	// assignment apply) plus AllowPrivilegeEscalation=false,
+	// ReadOnlyRootFilesystem=true, Capabilities.Drop=["ALL"], and
+	// SeccompProfile=RuntimeDefault, so the operator can run under
+	// restricted-v2 instead of requiring an elevated SCC. Defaults to true via
+	// the --enable-restricted-scc install flag, whether or not the cluster is
+	// OpenShift.
+	EnableRestrictedSCC bool
+	// Privileged mirrors HyperShiftOperatorClusterRole.Privileged: when false
+	// the operator's own security context is hardened to match the
+	// hypershift-restricted SCC it is bound to instead of the built-in
+	// privileged SCC. Defaults to true via the --privileged install flag.
+	Privileged       bool
+	PrivatePlatform  string
+	AWSPrivateCreds  string
+	AWSPrivateRegion string
+	// AWSPrivateRoleArn, when set, switches the AWS private-link credentials
+	// from a static access-key file to IRSA/STS web-identity auth: a
+	// projected ServiceAccountToken is mounted and AWS_ROLE_ARN /
+	// AWS_WEB_IDENTITY_TOKEN_FILE are set so the aws-sdk-go v2 credential
+	// chain picks up the token instead of requiring AWSPrivateCreds.
+	AWSPrivateRoleArn              string
+	AWSPrivateSTSAudience          string
 	OIDCBucketName                 string
 	OIDCBucketRegion               string
 	OIDCStorageProviderS3Secret    *corev1.Secret
 	OIDCStorageProviderS3SecretKey string
+	// OIDCStorageProviderS3RoleArn, when set, publishes OIDC documents to S3
+	// using IRSA/STS instead of the static credentials in
+	// OIDCStorageProviderS3Secret. Mutually exclusive with that field.
+	OIDCStorageProviderS3RoleArn string
+	// OIDCStorage selects a --oidc-storage-backend other than the legacy,
+	// S3-only OIDCBucketName/OIDCStorageProviderS3* fields above. When set,
+	// it takes precedence over those fields; OIDCStorageCredsSecret carries
+	// whatever credentials the backend's CredentialsKey names, if any.
+	OIDCStorage            storage.Backend
+	OIDCStorageCredsSecret *corev1.Secret
+}
+
+// ValidateAWSCredentialOptions rejects the combination of static AWS
+// credentials and an STS role ARN for the same component, since the two
+// authentication modes are mutually exclusive.
+func ValidateAWSCredentialOptions(credsBytes []byte, roleArn string) error {
+	if len(credsBytes) > 0 && len(roleArn) > 0 {
+		return fmt.Errorf("cannot specify both static AWS credentials and a role ARN")
+	}
+	return nil
 }
 
 func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
+	var containerSecurityContext *corev1.SecurityContext
+	var podSecurityContext *corev1.PodSecurityContext
+	if o.EnableRestrictedSCC || !o.Privileged {
+		containerSecurityContext = &corev1.SecurityContext{
+			AllowPrivilegeEscalation: k8sutilspointer.BoolPtr(false),
+			ReadOnlyRootFilesystem:   k8sutilspointer.BoolPtr(true),
+			RunAsNonRoot:             k8sutilspointer.BoolPtr(true),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}
+		podSecurityContext = &corev1.PodSecurityContext{}
+	} else {
+		// needed since hypershift operator runs with anyuid scc
+		containerSecurityContext = &corev1.SecurityContext{
+			RunAsUser: k8sutilspointer.Int64Ptr(1000),
+		}
+	}
+
 	args := []string{
 		"run",
 		"--namespace=$(MY_NAMESPACE)",
@@ -277,7 +518,58 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 			},
 		},
 	}
-	if len(o.OIDCBucketName) > 0 && len(o.OIDCBucketRegion) > 0 && len(o.OIDCStorageProviderS3SecretKey) > 0 &&
+	if o.OIDCStorage != nil {
+		args = append(args, "--oidc-storage-backend="+o.OIDCStorage.Kind())
+		args = append(args, o.OIDCStorage.Args()...)
+		if o.OIDCStorageCredsSecret != nil {
+			if vol, mount := storage.Volume(o.OIDCStorage, o.OIDCStorageCredsSecret.Name); vol != nil {
+				oidcVolumeCred = []corev1.Volume{*vol}
+				oidcVolumeMount = []corev1.VolumeMount{*mount}
+			}
+		}
+		if s3, ok := o.OIDCStorage.(storage.S3Backend); ok && len(s3.RoleArn) > 0 {
+			envVars = append(envVars,
+				corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: s3.RoleArn},
+				corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/sts/token"},
+			)
+			oidcVolumeMount = []corev1.VolumeMount{{Name: "sts-token", MountPath: "/var/run/secrets/sts"}}
+			oidcVolumeCred = []corev1.Volume{{Name: "sts-token", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Audience: "openshift", Path: "token"}}},
+			}}}}
+		}
+	} else if len(o.OIDCBucketName) > 0 && len(o.OIDCBucketRegion) > 0 && len(o.OIDCStorageProviderS3RoleArn) > 0 {
+		args = append(args,
+			"--oidc-storage-provider-s3-bucket-name="+o.OIDCBucketName,
+			"--oidc-storage-provider-s3-region="+o.OIDCBucketRegion,
+		)
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: o.OIDCStorageProviderS3RoleArn},
+			corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/sts/token"},
+		)
+		oidcVolumeMount = []corev1.VolumeMount{
+			{
+				Name:      "sts-token",
+				MountPath: "/var/run/secrets/sts",
+			},
+		}
+		oidcVolumeCred = []corev1.Volume{
+			{
+				Name: "sts-token",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{
+								ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+									Audience: "openshift",
+									Path:     "token",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	} else if len(o.OIDCBucketName) > 0 && len(o.OIDCBucketRegion) > 0 && len(o.OIDCStorageProviderS3SecretKey) > 0 &&
 		o.OIDCStorageProviderS3Secret != nil && len(o.OIDCStorageProviderS3Secret.Name) > 0 {
 		args = append(args,
 			"--oidc-storage-provider-s3-bucket-name="+o.OIDCBucketName,
@@ -327,13 +619,11 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: o.ServiceAccount.Name,
+					SecurityContext:    podSecurityContext,
 					Containers: []corev1.Container{
 						{
-							Name: "operator",
-							// needed since hypershift operator runs with anyuuid scc
-							SecurityContext: &corev1.SecurityContext{
-								RunAsUser: k8sutilspointer.Int64Ptr(1000),
-							},
+							Name:            "operator",
+							SecurityContext: containerSecurityContext,
 							Image:           o.OperatorImage,
 							ImagePullPolicy: corev1.PullAlways,
 							Env:             envVars,
@@ -394,32 +684,54 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 		return deployment
 	}
 
-	// Add generic provider credentials secret volume
-	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
-		Name: "credentials",
-		VolumeSource: corev1.VolumeSource{
-			Secret: &corev1.SecretVolumeSource{
-				SecretName: awsCredsSecretName,
+	// Add generic provider credentials secret volume, unless STS/IRSA is in use.
+	if len(o.AWSPrivateRoleArn) == 0 {
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: awsCredsSecretName,
+				},
 			},
-		},
-	})
-	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-		Name:      "credentials",
-		MountPath: "/etc/provider",
-	})
+		})
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "credentials",
+			MountPath: "/etc/provider",
+		})
+	}
 
 	// Add platform specific settings
 	switch privatePlatformType {
 	case hyperv1.AWSPlatform:
-		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
-			corev1.EnvVar{
-				Name:  "AWS_SHARED_CREDENTIALS_FILE",
-				Value: "/etc/provider/credentials",
-			},
-			corev1.EnvVar{
-				Name:  "AWS_REGION",
-				Value: o.AWSPrivateRegion,
-			})
+		stsAudience := o.AWSPrivateSTSAudience
+		if stsAudience == "" {
+			stsAudience = "openshift"
+		}
+		if len(o.AWSPrivateRoleArn) > 0 {
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{
+					Name:  "AWS_ROLE_ARN",
+					Value: o.AWSPrivateRoleArn,
+				},
+				corev1.EnvVar{
+					Name:  "AWS_WEB_IDENTITY_TOKEN_FILE",
+					Value: "/var/run/secrets/openshift/serviceaccount/token",
+				},
+				corev1.EnvVar{
+					Name:  "AWS_REGION",
+					Value: o.AWSPrivateRegion,
+				})
+		} else {
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{
+					Name:  "AWS_SHARED_CREDENTIALS_FILE",
+					Value: "/etc/provider/credentials",
+				},
+				corev1.EnvVar{
+					Name:  "AWS_REGION",
+					Value: o.AWSPrivateRegion,
+				})
+		}
 		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
 			corev1.VolumeMount{
 				Name:      "token",
@@ -433,7 +745,7 @@ func (o HyperShiftOperatorDeployment) Build() *appsv1.Deployment {
 						Sources: []corev1.VolumeProjection{
 							{
 								ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
-									Audience: "openshift",
+									Audience: stsAudience,
 									Path:     "token",
 								},
 							},
@@ -577,7 +889,19 @@ func (o HyperShiftOperatorServiceAccount) Build() *corev1.ServiceAccount {
 	return sa
 }
 
-type HyperShiftOperatorClusterRole struct{}
+type HyperShiftOperatorClusterRole struct {
+	// EnableRestrictedSCC drops the blanket securitycontextconstraints "*"
+	// grant, since the operator no longer needs an elevated SCC once it runs
+	// under restricted-v2.
+	EnableRestrictedSCC bool
+	// Privileged selects which SCC the operator's ClusterRole is allowed to
+	// "use": the built-in "privileged" SCC when true (the current default),
+	// or the hypershift-restricted SCC built by HyperShiftRestrictedSCC when
+	// false, for clusters where admins forbid privileged workloads
+	// entirely. Ignored when EnableRestrictedSCC is set, since the operator
+	// then needs no SCC grant at all.
+	Privileged bool
+}
 
 func (o HyperShiftOperatorClusterRole) Build() *rbacv1.ClusterRole {
 	role := &rbacv1.ClusterRole{
@@ -651,11 +975,6 @@ func (o HyperShiftOperatorClusterRole) Build() *rbacv1.ClusterRole {
 				Resources: []string{"*"},
 				Verbs:     []string{"*"},
 			},
-			{
-				APIGroups: []string{"security.openshift.io"},
-				Resources: []string{"securitycontextconstraints"},
-				Verbs:     []string{"*"},
-			},
 			{
 				APIGroups: []string{"rbac.authorization.k8s.io"},
 				Resources: []string{"*"},
@@ -727,6 +1046,18 @@ func (o HyperShiftOperatorClusterRole) Build() *rbacv1.ClusterRole {
 			},
 		},
 	}
+	if !o.EnableRestrictedSCC {
+		sccName := "privileged"
+		if !o.Privileged {
+			sccName = "hypershift-restricted"
+		}
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups:     []string{"security.openshift.io"},
+			Resources:     []string{"securitycontextconstraints"},
+			ResourceNames: []string{sccName},
+			Verbs:         []string{"use"},
+		})
+	}
 	return role
 }
 
@@ -760,6 +1091,51 @@ func (o HyperShiftOperatorClusterRoleBinding) Build() *rbacv1.ClusterRoleBinding
 	return binding
 }
 
+// HyperShiftRestrictedSCC is installed when the operator runs with
+// Privileged=false, giving the operator's ServiceAccount a narrowly-scoped
+// SecurityContextConstraints to "use" instead of the cluster's built-in
+// "privileged" SCC, for clusters whose admins forbid privileged workloads.
+type HyperShiftRestrictedSCC struct {
+	ServiceAccount *corev1.ServiceAccount
+}
+
+func (o HyperShiftRestrictedSCC) Build() *securityv1.SecurityContextConstraints {
+	return &securityv1.SecurityContextConstraints{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SecurityContextConstraints",
+			APIVersion: securityv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hypershift-restricted",
+		},
+		AllowHostDirVolumePlugin: false,
+		AllowHostIPC:             false,
+		AllowHostNetwork:         false,
+		AllowHostPID:             false,
+		AllowHostPorts:           false,
+		AllowPrivilegedContainer: false,
+		AllowPrivilegeEscalation: k8sutilspointer.BoolPtr(false),
+		ReadOnlyRootFilesystem:   true,
+		RequiredDropCapabilities: []corev1.Capability{"ALL"},
+		RunAsUser: securityv1.RunAsUserStrategyOptions{
+			Type: securityv1.RunAsUserStrategyMustRunAsRange,
+		},
+		SELinuxContext: securityv1.SELinuxContextStrategyOptions{
+			Type: securityv1.SELinuxStrategyMustRunAs,
+		},
+		FSGroup: securityv1.FSGroupStrategyOptions{
+			Type: securityv1.FSGroupStrategyMustRunAs,
+		},
+		SupplementalGroups: securityv1.SupplementalGroupsStrategyOptions{
+			Type: securityv1.SupplementalGroupsStrategyRunAsAny,
+		},
+		SeccompProfiles: []string{corev1.SeccompProfileNameRuntimeDefault},
+		Users: []string{
+			fmt.Sprintf("system:serviceaccount:%s:%s", o.ServiceAccount.Namespace, o.ServiceAccount.Name),
+		},
+	}
+}
+
 type HyperShiftOperatorRole struct {
 	Namespace *corev1.Namespace
 }
@@ -818,57 +1194,133 @@ func (o HyperShiftOperatorRoleBinding) Build() *rbacv1.RoleBinding {
 	return binding
 }
 
-type HyperShiftControlPlanePriorityClass struct{}
+// PriorityClassConfig overrides the name, value, and preemption policy of a
+// single HyperShift PriorityClass, so operators on shared management
+// clusters can coexist with other priority classes (e.g.
+// system-cluster-critical) instead of being stuck with the compiled-in
+// values.
+type PriorityClassConfig struct {
+	Name string
+	// Value is a pointer so an explicitly configured value of 0 is not
+	// mistaken for "unset" and silently overridden by the compiled-in default.
+	Value            *int32
+	PreemptionPolicy *corev1.PreemptionPolicy
+}
+
+type HyperShiftControlPlanePriorityClass struct {
+	Config PriorityClassConfig
+}
 
 func (o HyperShiftControlPlanePriorityClass) Build() *schedulingv1.PriorityClass {
+	name := o.Config.Name
+	if name == "" {
+		name = DefaultPriorityClass
+	}
+	value := int32(100000000)
+	if o.Config.Value != nil {
+		value = *o.Config.Value
+	}
 	return &schedulingv1.PriorityClass{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "PriorityClass",
 			APIVersion: schedulingv1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: DefaultPriorityClass,
+			Name: name,
 		},
-		Value:         100000000,
-		GlobalDefault: false,
-		Description:   "This priority class should be used for hypershift control plane pods not critical to serving the API.",
+		Value:            value,
+		PreemptionPolicy: o.Config.PreemptionPolicy,
+		GlobalDefault:    false,
+		Description:      "This priority class should be used for hypershift control plane pods not critical to serving the API.",
 	}
 }
 
-type HyperShiftAPICriticalPriorityClass struct{}
+type HyperShiftAPICriticalPriorityClass struct {
+	Config PriorityClassConfig
+}
 
 func (o HyperShiftAPICriticalPriorityClass) Build() *schedulingv1.PriorityClass {
+	name := o.Config.Name
+	if name == "" {
+		name = APICriticalPriorityClass
+	}
+	value := int32(100001000)
+	if o.Config.Value != nil {
+		value = *o.Config.Value
+	}
 	return &schedulingv1.PriorityClass{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "PriorityClass",
 			APIVersion: schedulingv1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: APICriticalPriorityClass,
+			Name: name,
 		},
-		Value:         100001000,
-		GlobalDefault: false,
-		Description:   "This priority class should be used for hypershift control plane pods critical to serving the API.",
+		Value:            value,
+		PreemptionPolicy: o.Config.PreemptionPolicy,
+		GlobalDefault:    false,
+		Description:      "This priority class should be used for hypershift control plane pods critical to serving the API.",
 	}
 }
 
-type HyperShiftEtcdPriorityClass struct{}
+type HyperShiftEtcdPriorityClass struct {
+	Config PriorityClassConfig
+}
 
 func (o HyperShiftEtcdPriorityClass) Build() *schedulingv1.PriorityClass {
+	name := o.Config.Name
+	if name == "" {
+		name = EtcdPriorityClass
+	}
+	value := int32(100002000)
+	if o.Config.Value != nil {
+		value = *o.Config.Value
+	}
 	return &schedulingv1.PriorityClass{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "PriorityClass",
 			APIVersion: schedulingv1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: EtcdPriorityClass,
+			Name: name,
 		},
-		Value:         100002000,
-		GlobalDefault: false,
-		Description:   "This priority class should be used for hypershift etcd pods.",
+		Value:            value,
+		PreemptionPolicy: o.Config.PreemptionPolicy,
+		GlobalDefault:    false,
+		Description:      "This priority class should be used for hypershift etcd pods.",
 	}
 }
 
+// ValidatePriorityClassConfigs rejects PriorityClassConfig sets with
+// duplicate values/names across tiers, or values that fall inside the
+// reserved system-* priority range (>= 2000000000, per
+// k8s.io/kubernetes/pkg/apis/scheduling), so guest control-plane pods can
+// never outrank platform components.
+func ValidatePriorityClassConfigs(configs ...PriorityClassConfig) error {
+	const reservedSystemRangeStart = 2000000000
+
+	names := map[string]bool{}
+	values := map[int32]bool{}
+	for _, c := range configs {
+		if c.Name != "" {
+			if names[c.Name] {
+				return fmt.Errorf("priority class name %q is used more than once", c.Name)
+			}
+			names[c.Name] = true
+		}
+		if c.Value != nil {
+			if values[*c.Value] {
+				return fmt.Errorf("priority class value %d is used more than once", *c.Value)
+			}
+			values[*c.Value] = true
+			if *c.Value >= reservedSystemRangeStart {
+				return fmt.Errorf("priority class value %d overlaps the reserved system-* range", *c.Value)
+			}
+		}
+	}
+	return nil
+}
+
 type HyperShiftPrometheusRole struct {
 	Namespace *corev1.Namespace
 }
@@ -935,9 +1387,48 @@ func (o HyperShiftOperatorPrometheusRoleBinding) Build() *rbacv1.RoleBinding {
 
 type HyperShiftServiceMonitor struct {
 	Namespace *corev1.Namespace
+	// MetricsSet mirrors the --metrics-set install flag (Telemetry, SRE, or
+	// All). Telemetry scrapes only this namespace's operator Service; SRE and
+	// All additionally scrape the hosted control plane namespaces the
+	// operator creates, via NamespaceSelector.Any. Defaults to Telemetry.
+	MetricsSet string
+	// TLSConfig, when set, scrapes the metrics endpoint over HTTPS with the
+	// given CA/client certificate instead of plaintext HTTP.
+	TLSConfig *prometheusoperatorv1.TLSConfig
+	// BearerTokenSecret, when set, authenticates the scrape with a bearer
+	// token read from the given Secret key.
+	BearerTokenSecret *corev1.SecretKeySelector
+	// HonorLabels keeps a target's own labels instead of Prometheus's on
+	// conflict, needed once scraping spans namespaces and multiple control
+	// planes expose metrics under the same label names.
+	HonorLabels bool
+	// MetricRelabelings runs after scraping, e.g. to drop the high-cardinality
+	// hosted_cluster label before ingestion so a large HostedCluster fleet
+	// doesn't blow up Prometheus's series count.
+	MetricRelabelings []*prometheusoperatorv1.RelabelConfig
 }
 
 func (o HyperShiftServiceMonitor) Build() *prometheusoperatorv1.ServiceMonitor {
+	endpoint := prometheusoperatorv1.Endpoint{
+		Interval:             "30s",
+		Port:                 "metrics",
+		Scheme:               "http",
+		HonorLabels:          o.HonorLabels,
+		MetricRelabelConfigs: o.MetricRelabelings,
+	}
+	if o.TLSConfig != nil {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = o.TLSConfig
+	}
+	if o.BearerTokenSecret != nil {
+		endpoint.BearerTokenSecret = *o.BearerTokenSecret
+	}
+
+	namespaceSelector := prometheusoperatorv1.NamespaceSelector{MatchNames: []string{o.Namespace.Name}}
+	if o.MetricsSet == "SRE" || o.MetricsSet == "All" {
+		namespaceSelector = prometheusoperatorv1.NamespaceSelector{Any: true}
+	}
+
 	return &prometheusoperatorv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ServiceMonitor",
@@ -948,18 +1439,61 @@ func (o HyperShiftServiceMonitor) Build() *prometheusoperatorv1.ServiceMonitor {
 			Name:      "operator",
 		},
 		Spec: prometheusoperatorv1.ServiceMonitorSpec{
-			JobLabel: "component",
+			JobLabel:          "component",
+			NamespaceSelector: namespaceSelector,
 			Selector: metav1.LabelSelector{
 				MatchLabels: map[string]string{
 					"name": "operator",
 				},
 			},
-			Endpoints: []prometheusoperatorv1.Endpoint{
-				{
-					Interval: "30s",
-					Port:     "metrics",
+			Endpoints: []prometheusoperatorv1.Endpoint{endpoint},
+		},
+	}
+}
+
+// HyperShiftPodMonitor is the PodMonitor companion to HyperShiftServiceMonitor,
+// for hosted control plane components that expose metrics without a stable
+// Service in front of them. It scrapes pods labelled
+// hypershift.openshift.io/hosted-control-plane=true in every namespace, so
+// newly created control planes are picked up without per-cluster wiring;
+// namespaces are not filtered by label.
+type HyperShiftPodMonitor struct {
+	Namespace         *corev1.Namespace
+	TLSConfig         *prometheusoperatorv1.TLSConfig
+	BearerTokenSecret *corev1.SecretKeySelector
+}
+
+func (o HyperShiftPodMonitor) Build() *prometheusoperatorv1.PodMonitor {
+	endpoint := prometheusoperatorv1.PodMetricsEndpoint{
+		Interval: "30s",
+		Port:     "metrics",
+		Scheme:   "http",
+	}
+	if o.TLSConfig != nil {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = &prometheusoperatorv1.PodMetricsEndpointTLSConfig{SafeTLSConfig: o.TLSConfig.SafeTLSConfig}
+	}
+	if o.BearerTokenSecret != nil {
+		endpoint.BearerTokenSecret = *o.BearerTokenSecret
+	}
+
+	return &prometheusoperatorv1.PodMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodMonitor",
+			APIVersion: prometheusoperatorv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.Namespace.Name,
+			Name:      "hosted-control-planes",
+		},
+		Spec: prometheusoperatorv1.PodMonitorSpec{
+			NamespaceSelector: prometheusoperatorv1.NamespaceSelector{Any: true},
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"hypershift.openshift.io/hosted-control-plane": "true",
 				},
 			},
+			PodMetricsEndpoints: []prometheusoperatorv1.PodMetricsEndpoint{endpoint},
 		},
 	}
 }
@@ -984,6 +1518,153 @@ func (r HypershiftRecordingRule) Build() *prometheusoperatorv1.PrometheusRule {
 	return rule
 }
 
+// HypershiftAlertingRule ships the PrometheusRule alerts that accompany
+// HypershiftRecordingRule's recording rules: operator liveness, HostedCluster
+// condition health, and reconciliation error-rate signals. This struct sets
+// no namespace/hostedcluster labels: an alert carries them only where its
+// metric vector does, and HypershiftReconcileErrorRateHigh, which aggregates
+// by controller, carries neither.
+type HypershiftAlertingRule struct {
+	Namespace *corev1.Namespace
+	// MinSeverity suppresses any alert whose natural severity is below this
+	// floor: setting it to "critical" drops every "warning" alert from the
+	// PrometheusRule entirely, for installs that only want to page on
+	// critical conditions. Surviving alerts keep their natural severity
+	// label unchanged. Defaults to "warning" (nothing suppressed) via the
+	// --alert-severity-floor install flag.
+	MinSeverity string
+}
+
+func (r HypershiftAlertingRule) Build() *prometheusoperatorv1.PrometheusRule {
+	rule := &prometheusoperatorv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PrometheusRule",
+			APIVersion: prometheusoperatorv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace.Name,
+			Name:      "alerts",
+		},
+	}
+	rule.Spec = alertingRuleSpec(r.minSeverity())
+	return rule
+}
+
+// minSeverity returns r.MinSeverity, defaulting to "warning" (the lowest
+// severity, so nothing is suppressed) when unset.
+func (r HypershiftAlertingRule) minSeverity() string {
+	if r.MinSeverity == "" {
+		return "warning"
+	}
+	return r.MinSeverity
+}
+
+// severityRank orders severities from least to most severe, so alerts below
+// a configured floor can be filtered out by comparison.
+func severityRank(severity string) int {
+	if severity == "critical" {
+		return 1
+	}
+	return 0
+}
+
+// alertingRuleSpec builds the hypershift.rules PrometheusRule group,
+// dropping any alert whose severity label falls below minSeverity.
+func alertingRuleSpec(minSeverity string) prometheusoperatorv1.PrometheusRuleSpec {
+	floor := severityRank(minSeverity)
+	allRules := []prometheusoperatorv1.Rule{
+		{
+			Alert:  "HypershiftOperatorDown",
+			Expr:   intstr.FromString(`up{job="operator"} == 0`),
+			For:    "5m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary":     "The HyperShift operator is down.",
+				"description": "The HyperShift operator has not reported up{job=\"operator\"} for 5 minutes; no HostedClusters are being reconciled.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/hypershift-operator-down.md",
+			},
+		},
+		{
+			Alert:  "HostedClusterAvailableConditionFalse",
+			Expr:   intstr.FromString(`hypershift_hostedcluster_condition{type="Available", status="false"} == 1`),
+			For:    "10m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary":     "HostedCluster {{ $labels.namespace }}/{{ $labels.hostedcluster }} is not Available.",
+				"description": "HostedCluster {{ $labels.namespace }}/{{ $labels.hostedcluster }} has reported Available=False for over 10 minutes.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/hostedcluster-available-false.md",
+			},
+		},
+		{
+			Alert:  "HostedClusterDegradedConditionTrue",
+			Expr:   intstr.FromString(`hypershift_hostedcluster_condition{type="Degraded", status="true"} == 1`),
+			For:    "10m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "HostedCluster {{ $labels.namespace }}/{{ $labels.hostedcluster }} is Degraded.",
+				"description": "HostedCluster {{ $labels.namespace }}/{{ $labels.hostedcluster }} has reported Degraded=True for over 10 minutes.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/hostedcluster-degraded-true.md",
+			},
+		},
+		{
+			Alert:  "NodePoolReplicasMismatch",
+			Expr:   intstr.FromString(`hypershift_nodepool_replicas_desired != hypershift_nodepool_replicas_ready`),
+			For:    "30m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "NodePool {{ $labels.namespace }}/{{ $labels.name }} has not reached its desired replica count.",
+				"description": "NodePool {{ $labels.namespace }}/{{ $labels.name }} has differed from its desired replica count for over 30 minutes.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/nodepool-replicas-mismatch.md",
+			},
+		},
+		{
+			Alert:  "HostedControlPlaneEtcdMembersDown",
+			Expr:   intstr.FromString(`up{job="etcd"} == 0`),
+			For:    "5m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary":     "An etcd member in HostedCluster {{ $labels.namespace }} is down.",
+				"description": "An etcd member in the control plane namespace {{ $labels.namespace }} has not reported up{job=\"etcd\"} for 5 minutes.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/hosted-control-plane-etcd-members-down.md",
+			},
+		},
+		{
+			Alert: "HypershiftReconcileErrorRateHigh",
+			Expr: intstr.FromString(
+				`sum(rate(controller_runtime_reconcile_errors_total{job="operator"}[5m])) by (controller) / sum(rate(controller_runtime_reconcile_total{job="operator"}[5m])) by (controller) > 0.1`,
+			),
+			For:    "15m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "Controller {{ $labels.controller }} is failing more than 10% of its reconciles.",
+				"description": "Controller {{ $labels.controller }} in the HyperShift operator has failed more than 10% of its reconciles over the last 5 minutes.",
+				"runbook_url": "https://github.com/openshift/hypershift/blob/main/docs/content/reference/runbooks/hypershift-reconcile-error-rate-high.md",
+			},
+		},
+	}
+
+	var rules []prometheusoperatorv1.Rule
+	for _, rule := range allRules {
+		if severityRank(rule.Labels["severity"]) >= floor {
+			rules = append(rules, rule)
+		}
+	}
+
+	return prometheusoperatorv1.PrometheusRuleSpec{
+		Groups: []prometheusoperatorv1.RuleGroup{
+			{
+				Name:  "hypershift.rules",
+				Rules: rules,
+			},
+		},
+	}
+}
+
+// hyperShiftClientAggregateToLabel marks HyperShiftClientClusterRole so
+// cluster admins can fold its rules into a broader aggregated ClusterRole of
+// their own, the same way HyperShiftReaderClusterRole's children aggregate.
+const hyperShiftClientAggregateToLabel = "rbac.authorization.k8s.io/aggregate-to-hypershift-client"
+
 type HyperShiftClientClusterRole struct{}
 
 func (o HyperShiftClientClusterRole) Build() *rbacv1.ClusterRole {
@@ -993,7 +1674,8 @@ func (o HyperShiftClientClusterRole) Build() *rbacv1.ClusterRole {
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "hypershift-client",
+			Name:   "hypershift-client",
+			Labels: map[string]string{hyperShiftClientAggregateToLabel: "true"},
 		},
 		Rules: []rbacv1.PolicyRule{
 			{
@@ -1060,10 +1742,20 @@ func (o HyperShiftClientClusterRoleBinding) Build() *rbacv1.ClusterRoleBinding {
 	return binding
 }
 
+// hyperShiftReaderAggregateToLabel marks a child ClusterRole for aggregation
+// into hypershift-readers; see HyperShiftReaderClusterRole.
+const hyperShiftReaderAggregateToLabel = "rbac.authorization.k8s.io/aggregate-to-hypershift-reader"
+
+// HyperShiftReaderClusterRole is now an aggregated ClusterRole: it carries no
+// Rules of its own, and instead collects them at reconcile time from every
+// ClusterRole labelled hyperShiftReaderAggregateToLabel=true (the
+// HyperShiftReader*ClusterRole types below). This lets cluster admins grant
+// read access to additional resources by labelling their own ClusterRoles,
+// the same way the built-in admin/edit roles aggregate.
 type HyperShiftReaderClusterRole struct{}
 
 func (o HyperShiftReaderClusterRole) Build() *rbacv1.ClusterRole {
-	role := &rbacv1.ClusterRole{
+	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ClusterRole",
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
@@ -1071,6 +1763,30 @@ func (o HyperShiftReaderClusterRole) Build() *rbacv1.ClusterRole {
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "hypershift-readers",
 		},
+		AggregationRule: &rbacv1.AggregationRule{
+			ClusterRoleSelectors: []metav1.LabelSelector{
+				{
+					MatchLabels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+				},
+			},
+		},
+	}
+}
+
+// HyperShiftReaderCoreClusterRole grants read access to the hypershift API
+// and the core workload/RBAC resources the CLI and UIs inspect directly.
+type HyperShiftReaderCoreClusterRole struct{}
+
+func (o HyperShiftReaderCoreClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "hypershift-reader-core",
+			Labels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+		},
 		Rules: []rbacv1.PolicyRule{
 			{
 				APIGroups: []string{"hypershift.openshift.io"},
@@ -1078,20 +1794,53 @@ func (o HyperShiftReaderClusterRole) Build() *rbacv1.ClusterRole {
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"config.openshift.io"},
-				Resources: []string{"*"},
+				APIGroups: []string{"apiextensions.k8s.io"},
+				Resources: []string{"customresourcedefinitions"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"apiextensions.k8s.io"},
-				Resources: []string{"customresourcedefinitions"},
+				APIGroups: []string{"rbac.authorization.k8s.io"},
+				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"networking.k8s.io"},
-				Resources: []string{"networkpolicies"},
+				APIGroups: []string{""},
+				Resources: []string{
+					"events",
+					"configmaps",
+					"pods",
+					"pods/log",
+					"nodes",
+					"namespaces",
+					"serviceaccounts",
+					"services",
+				},
+				Verbs: []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
+		},
+	}
+}
+
+// HyperShiftReaderCAPIClusterRole grants read access to the Cluster API
+// provider resources HostedClusters are reconciled into.
+type HyperShiftReaderCAPIClusterRole struct{}
+
+func (o HyperShiftReaderCAPIClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "hypershift-reader-capi",
+			Labels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+		},
+		Rules: []rbacv1.PolicyRule{
 			{
 				APIGroups: []string{
 					"bootstrap.cluster.x-k8s.io",
@@ -1107,67 +1856,119 @@ func (o HyperShiftReaderClusterRole) Build() *rbacv1.ClusterRole {
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"operator.openshift.io"},
+				APIGroups: []string{"machine.openshift.io"},
 				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"route.openshift.io"},
+				APIGroups: []string{"etcd.database.coreos.com"},
 				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"security.openshift.io"},
-				Resources: []string{"securitycontextconstraints"},
+				APIGroups: []string{"capi-provider.agent-install.openshift.io"},
+				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
+		},
+	}
+}
+
+// HyperShiftReaderOpenShiftConfigClusterRole grants read access to
+// cluster-scoped OpenShift configuration and routing resources.
+type HyperShiftReaderOpenShiftConfigClusterRole struct{}
+
+func (o HyperShiftReaderOpenShiftConfigClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "hypershift-reader-openshift-config",
+			Labels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+		},
+		Rules: []rbacv1.PolicyRule{
 			{
-				APIGroups: []string{"rbac.authorization.k8s.io"},
+				APIGroups: []string{"config.openshift.io"},
 				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{""},
-				Resources: []string{
-					"events",
-					"configmaps",
-					"pods",
-					"pods/log",
-					"nodes",
-					"namespaces",
-					"serviceaccounts",
-					"services",
-				},
-				Verbs: []string{"get", "list", "watch"},
+				APIGroups: []string{"operator.openshift.io"},
+				Resources: []string{"*"},
+				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"apps"},
-				Resources: []string{"deployments"},
+				APIGroups: []string{"route.openshift.io"},
+				Resources: []string{"*"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"etcd.database.coreos.com"},
-				Resources: []string{"*"},
+				APIGroups: []string{"security.openshift.io"},
+				Resources: []string{"securitycontextconstraints"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 			{
-				APIGroups: []string{"machine.openshift.io"},
-				Resources: []string{"*"},
+				APIGroups: []string{"networking.k8s.io"},
+				Resources: []string{"networkpolicies"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
+		},
+	}
+}
+
+// HyperShiftReaderMonitoringClusterRole grants read access to the
+// PodMonitors the operator and its control planes publish.
+type HyperShiftReaderMonitoringClusterRole struct{}
+
+func (o HyperShiftReaderMonitoringClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "hypershift-reader-monitoring",
+			Labels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+		},
+		Rules: []rbacv1.PolicyRule{
 			{
 				APIGroups: []string{"monitoring.coreos.com"},
 				Resources: []string{"podmonitors"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
+		},
+	}
+}
+
+// HyperShiftReaderAgentClusterRole grants read access to the Agent-based
+// install and KubeVirt resources Agent/KubeVirt-platform HostedClusters use.
+type HyperShiftReaderAgentClusterRole struct{}
+
+func (o HyperShiftReaderAgentClusterRole) Build() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "hypershift-reader-agent",
+			Labels: map[string]string{hyperShiftReaderAggregateToLabel: "true"},
+		},
+		Rules: []rbacv1.PolicyRule{
 			{
-				APIGroups: []string{"capi-provider.agent-install.openshift.io"},
-				Resources: []string{"*"},
+				APIGroups: []string{"agent-install.openshift.io"},
+				Resources: []string{"agents"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"kubevirt.io"},
+				Resources: []string{"virtualmachineinstances", "virtualmachines"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
 		},
 	}
-	return role
 }
 
 type HyperShiftReaderClusterRoleBinding struct {
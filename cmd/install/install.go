@@ -0,0 +1,642 @@
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/hypershift/cmd/install/assets"
+	"github.com/openshift/hypershift/pkg/oidc/storage"
+)
+
+// Options are the user-facing flags for `hypershift install`.
+type Options struct {
+	Namespace                  string
+	OperatorImage              string
+	Replicas                   int32
+	EnableOCPClusterMonitoring bool
+	EnableCIDebugOutput        bool
+	PrivatePlatform            string
+	Output                     string
+	// EnableRestrictedSCC runs the operator under restricted-v2 instead of
+	// requiring the privileged SCC. Defaults to true.
+	EnableRestrictedSCC bool
+	// Privileged selects whether the operator's ClusterRole and Deployment
+	// bind to the built-in privileged SCC (true, the default) or to the
+	// narrower hypershift-restricted SCC (false), for clusters where admins
+	// forbid privileged workloads. It has no effect on the ClusterRole or the
+	// SCC objects while EnableRestrictedSCC is set.
+	Privileged bool
+
+	ExternalDNSProvider           string
+	ExternalDNSImage              string
+	ExternalDNSDomainFilter       string
+	ExternalDNSCredentials        string
+	ExternalDNSTXTPrefix          string
+	ExternalDNSRegistry           string
+	ExternalDNSTXTOwnerID         string
+	ExternalDNSReplicas           int32
+	ExternalDNSZoneType           string
+	ExternalDNSAzureResourceGroup string
+	ExternalDNSGCPProject         string
+	ExternalDNSInfobloxGridHost   string
+	// ExternalDNSRoleArn, when set, switches the aws external-dns provider to
+	// IRSA/STS web-identity auth instead of ExternalDNSCredentials.
+	ExternalDNSRoleArn     string
+	ExternalDNSSTSAudience string
+
+	// AWSPrivateRoleArn, when set, switches the private-link AWS credentials
+	// to IRSA/STS web-identity auth instead of AWSPrivateCreds.
+	AWSPrivateCreds       string
+	AWSPrivateRegion      string
+	AWSPrivateRoleArn     string
+	AWSPrivateSTSAudience string
+
+	// OIDCStorageProviderS3RoleArn, when set, switches OIDC document
+	// publishing to S3 to IRSA/STS web-identity auth instead of
+	// OIDCStorageProviderS3Credentials.
+	OIDCBucketName                   string
+	OIDCBucketRegion                 string
+	OIDCStorageProviderS3Credentials string
+	OIDCStorageProviderS3RoleArn     string
+
+	// OIDCStorageBackend selects which storage.Backend publishes OIDC
+	// discovery documents: s3, gcs, azureblob, or filesystem. Defaults to s3
+	// using the OIDCBucketName/OIDCBucketRegion/OIDCStorageProviderS3* flags
+	// above.
+	OIDCStorageBackend      string
+	OIDCGCSBucket           string
+	OIDCGCSProject          string
+	OIDCGCSCredentials      string
+	OIDCAzureStorageAccount string
+	OIDCAzureContainer      string
+	OIDCAzureUseMSI         bool
+	OIDCAzureCredentials    string
+	OIDCFilesystemPath      string
+
+	// ControlPlanePriority, APICriticalPriority, and EtcdPriority override the
+	// compiled-in PriorityClass values, so HyperShift can coexist with other
+	// priority classes on shared management clusters. Unset (nil) keeps the
+	// compiled-in default for that tier.
+	ControlPlanePriority *int32
+	APICriticalPriority  *int32
+	EtcdPriority         *int32
+	// SkipPriorityClasses omits the PriorityClasses entirely, for clusters
+	// where admins manage them out-of-band.
+	SkipPriorityClasses bool
+
+	// ReaderGroup, when set, binds the given group to the aggregated
+	// hypershift-readers ClusterRole, granting it read access to
+	// HyperShift's CRs and the resources it manages.
+	ReaderGroup string
+	// ClientGroup, when set, binds the given group to the hypershift-client
+	// ClusterRole, granting it create/update/delete access to HostedClusters
+	// and NodePools.
+	ClientGroup string
+
+	// AlertSeverityFloor suppresses alerts in the shipped PrometheusRule
+	// whose natural severity falls below this floor; "critical" drops every
+	// "warning" alert. Defaults to "warning" (nothing suppressed).
+	AlertSeverityFloor string
+	// DisableDefaultAlerts omits the alerting and recording PrometheusRules
+	// entirely, for installs that manage alerting out-of-band.
+	DisableDefaultAlerts bool
+
+	// MetricsSet selects how much is scraped by the shipped ServiceMonitor:
+	// Telemetry (the operator's own namespace only), SRE, or All (both
+	// additionally scrape every namespace). The PodMonitor scrapes every
+	// namespace whatever the set. Defaults to Telemetry.
+	MetricsSet string
+	// MetricsTLSSecret, when set, scrapes metrics over HTTPS using the
+	// ca.crt/tls.crt/tls.key keys of this Secret instead of plaintext HTTP.
+	MetricsTLSSecret string
+	// MetricsBearerTokenSecret and MetricsBearerTokenSecretKey, when both
+	// set, authenticate the scrape with a bearer token read from the given
+	// Secret key.
+	MetricsBearerTokenSecret    string
+	MetricsBearerTokenSecretKey string
+}
+
+// NewCommand renders the manifests that make up a HyperShift operator
+// install: the operator Deployment and its RBAC, and ExternalDNS when
+// ExternalDNSProvider is set.
+func NewCommand() *cobra.Command {
+	opts := &Options{
+		Namespace:           "hypershift",
+		Replicas:            1,
+		PrivatePlatform:     "None",
+		Output:              "yaml",
+		EnableRestrictedSCC: true,
+		Privileged:          true,
+	}
+
+	cmd := &cobra.Command{
+		Use:          "install",
+		Short:        "Render the manifests that install the HyperShift operator",
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "Namespace to install the HyperShift operator into")
+	cmd.Flags().StringVar(&opts.OperatorImage, "hypershift-image", opts.OperatorImage, "Image to use for the HyperShift operator")
+	cmd.Flags().Int32Var(&opts.Replicas, "replicas", opts.Replicas, "Number of HyperShift operator replicas")
+	cmd.Flags().BoolVar(&opts.EnableOCPClusterMonitoring, "enable-ocp-cluster-monitoring", opts.EnableOCPClusterMonitoring, "Enable OCP cluster monitoring for the operator and its control planes")
+	cmd.Flags().BoolVar(&opts.EnableCIDebugOutput, "enable-ci-debug-output", opts.EnableCIDebugOutput, "Enable additional debug output used in CI runs")
+	cmd.Flags().StringVar(&opts.PrivatePlatform, "private-platform", opts.PrivatePlatform, "Platform on which private endpoints are supported (AWS or None)")
+	cmd.Flags().StringVar(&opts.Output, "output", opts.Output, "Output format for the rendered manifests: yaml or json")
+	cmd.Flags().BoolVar(&opts.EnableRestrictedSCC, "enable-restricted-scc", opts.EnableRestrictedSCC, "Run the operator under restricted-v2 instead of requiring the privileged SCC")
+	cmd.Flags().BoolVar(&opts.Privileged, "privileged", opts.Privileged, "Bind the operator to the built-in privileged SCC; set to false to bind to the narrower hypershift-restricted SCC instead; has no effect while --enable-restricted-scc is set")
+
+	cmd.Flags().StringVar(&opts.ExternalDNSProvider, "external-dns-provider", opts.ExternalDNSProvider, "External DNS provider to install (aws, azure, azure-private-dns, google, cloudflare, infoblox); omit to skip")
+	cmd.Flags().StringVar(&opts.ExternalDNSImage, "external-dns-image", opts.ExternalDNSImage, "Image to use for the external-dns Deployment")
+	cmd.Flags().StringVar(&opts.ExternalDNSDomainFilter, "external-dns-domain-filter", opts.ExternalDNSDomainFilter, "Restrict external-dns to this domain")
+	cmd.Flags().StringVar(&opts.ExternalDNSCredentials, "external-dns-credentials", opts.ExternalDNSCredentials, "Path to the credentials file for the external-dns provider")
+	cmd.Flags().StringVar(&opts.ExternalDNSTXTPrefix, "external-dns-txt-prefix", opts.ExternalDNSTXTPrefix, "Prefix to use for TXT registry records")
+	cmd.Flags().StringVar(&opts.ExternalDNSRegistry, "external-dns-registry", opts.ExternalDNSRegistry, "external-dns registry mode: noop or txt")
+	cmd.Flags().StringVar(&opts.ExternalDNSTXTOwnerID, "external-dns-txt-owner-id", opts.ExternalDNSTXTOwnerID, "Owner ID to use for TXT registry records")
+	cmd.Flags().Int32Var(&opts.ExternalDNSReplicas, "external-dns-replicas", opts.ExternalDNSReplicas, "Number of external-dns replicas")
+	cmd.Flags().StringVar(&opts.ExternalDNSZoneType, "external-dns-aws-zone-type", opts.ExternalDNSZoneType, "Zone type for the aws external-dns provider: public or private")
+	cmd.Flags().StringVar(&opts.ExternalDNSAzureResourceGroup, "external-dns-azure-resource-group", opts.ExternalDNSAzureResourceGroup, "Resource group for the azure/azure-private-dns external-dns provider")
+	cmd.Flags().StringVar(&opts.ExternalDNSGCPProject, "external-dns-gcp-project", opts.ExternalDNSGCPProject, "Project for the google external-dns provider")
+	cmd.Flags().StringVar(&opts.ExternalDNSInfobloxGridHost, "external-dns-infoblox-grid-host", opts.ExternalDNSInfobloxGridHost, "Grid host for the infoblox external-dns provider")
+	cmd.Flags().StringVar(&opts.ExternalDNSRoleArn, "external-dns-role-arn", opts.ExternalDNSRoleArn, "AWS role ARN for the aws external-dns provider to assume via IRSA/STS instead of --external-dns-credentials")
+	cmd.Flags().StringVar(&opts.ExternalDNSSTSAudience, "external-dns-sts-audience", opts.ExternalDNSSTSAudience, "STS audience for --external-dns-role-arn")
+
+	cmd.Flags().StringVar(&opts.AWSPrivateCreds, "aws-private-creds", opts.AWSPrivateCreds, "Path to the AWS credentials file used for private-link support")
+	cmd.Flags().StringVar(&opts.AWSPrivateRegion, "aws-private-region", opts.AWSPrivateRegion, "AWS region used for private-link support")
+	cmd.Flags().StringVar(&opts.AWSPrivateRoleArn, "role-arn", opts.AWSPrivateRoleArn, "AWS role ARN to assume via IRSA/STS instead of --aws-private-creds")
+	cmd.Flags().StringVar(&opts.AWSPrivateSTSAudience, "sts-audience", opts.AWSPrivateSTSAudience, "STS audience for --role-arn")
+
+	cmd.Flags().StringVar(&opts.OIDCBucketName, "oidc-storage-provider-s3-bucket-name", opts.OIDCBucketName, "S3 bucket name to publish OIDC documents to")
+	cmd.Flags().StringVar(&opts.OIDCBucketRegion, "oidc-storage-provider-s3-region", opts.OIDCBucketRegion, "Region of the S3 bucket named by --oidc-storage-provider-s3-bucket-name")
+	cmd.Flags().StringVar(&opts.OIDCStorageProviderS3Credentials, "oidc-storage-provider-s3-credentials", opts.OIDCStorageProviderS3Credentials, "Path to the S3 credentials file used to publish OIDC documents")
+	cmd.Flags().StringVar(&opts.OIDCStorageProviderS3RoleArn, "oidc-storage-provider-s3-role-arn", opts.OIDCStorageProviderS3RoleArn, "AWS role ARN to assume via IRSA/STS instead of --oidc-storage-provider-s3-credentials")
+
+	cmd.Flags().StringVar(&opts.OIDCStorageBackend, "oidc-storage-backend", "s3", "Where to publish OIDC discovery documents: s3, gcs, azureblob, or filesystem")
+	cmd.Flags().StringVar(&opts.OIDCGCSBucket, "oidc-storage-provider-gcs-bucket-name", opts.OIDCGCSBucket, "GCS bucket name to publish OIDC documents to")
+	cmd.Flags().StringVar(&opts.OIDCGCSProject, "oidc-storage-provider-gcs-project", opts.OIDCGCSProject, "Project of the GCS bucket named by --oidc-storage-provider-gcs-bucket-name")
+	cmd.Flags().StringVar(&opts.OIDCGCSCredentials, "oidc-storage-provider-gcs-credentials", opts.OIDCGCSCredentials, "Path to the workload-identity service account JSON used to publish OIDC documents to GCS")
+	cmd.Flags().StringVar(&opts.OIDCAzureStorageAccount, "oidc-storage-provider-azure-storage-account", opts.OIDCAzureStorageAccount, "Azure storage account to publish OIDC documents to")
+	cmd.Flags().StringVar(&opts.OIDCAzureContainer, "oidc-storage-provider-azure-container", opts.OIDCAzureContainer, "Azure Blob container to publish OIDC documents to")
+	cmd.Flags().BoolVar(&opts.OIDCAzureUseMSI, "oidc-storage-provider-azure-use-msi", opts.OIDCAzureUseMSI, "Authenticate to Azure Blob Storage using the node's MSI instead of a SAS token")
+	cmd.Flags().StringVar(&opts.OIDCAzureCredentials, "oidc-storage-provider-azure-credentials", opts.OIDCAzureCredentials, "Path to the SAS token used to publish OIDC documents to Azure Blob Storage")
+	cmd.Flags().StringVar(&opts.OIDCFilesystemPath, "oidc-storage-provider-filesystem-path", opts.OIDCFilesystemPath, "Path inside the operator container to serve OIDC documents from directly; no volume is mounted at this path")
+
+	var controlPlanePriority, apiCriticalPriority, etcdPriority int32
+	cmd.Flags().Int32Var(&controlPlanePriority, "control-plane-priority", 0, "Overrides the value of the hypershift-control-plane PriorityClass")
+	cmd.Flags().Int32Var(&apiCriticalPriority, "api-critical-priority", 0, "Overrides the value of the hypershift-api-critical PriorityClass")
+	cmd.Flags().Int32Var(&etcdPriority, "etcd-priority", 0, "Overrides the value of the hypershift-etcd PriorityClass")
+	cmd.Flags().BoolVar(&opts.SkipPriorityClasses, "skip-priority-classes", opts.SkipPriorityClasses, "Don't create HyperShift's PriorityClasses, for clusters where they're managed out-of-band")
+
+	cmd.Flags().StringVar(&opts.ReaderGroup, "reader-group", opts.ReaderGroup, "Group to bind to the aggregated hypershift-readers ClusterRole; omit to skip the binding")
+	cmd.Flags().StringVar(&opts.ClientGroup, "client-group", opts.ClientGroup, "Group to bind to the hypershift-client ClusterRole; omit to skip the binding")
+
+	cmd.Flags().StringVar(&opts.AlertSeverityFloor, "alert-severity-floor", "warning", "Suppress alerts below this severity: warning or critical")
+	cmd.Flags().BoolVar(&opts.DisableDefaultAlerts, "disable-default-alerts", opts.DisableDefaultAlerts, "Don't install the default alerting and recording PrometheusRules")
+
+	cmd.Flags().StringVar(&opts.MetricsSet, "metrics-set", "Telemetry", "How much to scrape with the shipped ServiceMonitor/PodMonitor: Telemetry, SRE, or All")
+	cmd.Flags().StringVar(&opts.MetricsTLSSecret, "metrics-tls-secret", opts.MetricsTLSSecret, "Secret (with ca.crt, tls.crt, and tls.key keys) to scrape metrics over HTTPS instead of plaintext HTTP; omit to scrape over HTTP")
+	cmd.Flags().StringVar(&opts.MetricsBearerTokenSecret, "metrics-bearer-token-secret", opts.MetricsBearerTokenSecret, "Secret holding a bearer token to authenticate the metrics scrape; requires --metrics-bearer-token-secret-key")
+	cmd.Flags().StringVar(&opts.MetricsBearerTokenSecretKey, "metrics-bearer-token-secret-key", "token", "Key within --metrics-bearer-token-secret holding the bearer token")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("control-plane-priority") {
+			opts.ControlPlanePriority = &controlPlanePriority
+		}
+		if cmd.Flags().Changed("api-critical-priority") {
+			opts.APICriticalPriority = &apiCriticalPriority
+		}
+		if cmd.Flags().Changed("etcd-priority") {
+			opts.EtcdPriority = &etcdPriority
+		}
+		if err := opts.Validate(); err != nil {
+			return err
+		}
+		objects, err := opts.Resources()
+		if err != nil {
+			return err
+		}
+		return renderManifests(objects, opts.Output, cmd.OutOrStdout())
+	}
+
+	return cmd
+}
+
+// readCredentialsFile reads the credentials file at path and returns its
+// contents, or nil if path is empty, so a path read earlier in Validate
+// surfaces a missing/unreadable file before Resources tries to use it.
+func readCredentialsFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// Validate rejects flag combinations that supply both static AWS
+// credentials and a role ARN for the same component, since the two
+// authentication modes are mutually exclusive.
+func (o *Options) Validate() error {
+	awsPrivateCreds, err := readCredentialsFile(o.AWSPrivateCreds)
+	if err != nil {
+		return fmt.Errorf("invalid private-link AWS credentials: %w", err)
+	}
+	if err := assets.ValidateAWSCredentialOptions(awsPrivateCreds, o.AWSPrivateRoleArn); err != nil {
+		return fmt.Errorf("invalid private-link AWS credentials: %w", err)
+	}
+	oidcStorageProviderS3Creds, err := readCredentialsFile(o.OIDCStorageProviderS3Credentials)
+	if err != nil {
+		return fmt.Errorf("invalid oidc storage provider s3 credentials: %w", err)
+	}
+	if err := assets.ValidateAWSCredentialOptions(oidcStorageProviderS3Creds, o.OIDCStorageProviderS3RoleArn); err != nil {
+		return fmt.Errorf("invalid oidc storage provider s3 credentials: %w", err)
+	}
+	externalDNSCreds, err := readCredentialsFile(o.ExternalDNSCredentials)
+	if err != nil {
+		return fmt.Errorf("invalid external-dns credentials: %w", err)
+	}
+	if err := assets.ValidateAWSCredentialOptions(externalDNSCreds, o.ExternalDNSRoleArn); err != nil {
+		return fmt.Errorf("invalid external-dns credentials: %w", err)
+	}
+	if err := storage.ValidateFlags(o.OIDCStorageBackend,
+		len(o.OIDCStorageProviderS3Credentials) > 0,
+		len(o.OIDCGCSCredentials) > 0,
+		len(o.OIDCAzureCredentials) > 0,
+	); err != nil {
+		return fmt.Errorf("invalid oidc storage backend flags: %w", err)
+	}
+	if !o.SkipPriorityClasses {
+		if err := assets.ValidatePriorityClassConfigs(
+			assets.PriorityClassConfig{Value: o.ControlPlanePriority},
+			assets.PriorityClassConfig{Value: o.APICriticalPriority},
+			assets.PriorityClassConfig{Value: o.EtcdPriority},
+		); err != nil {
+			return fmt.Errorf("invalid priority class values: %w", err)
+		}
+	}
+	switch o.MetricsSet {
+	case "Telemetry", "SRE", "All":
+	default:
+		return fmt.Errorf("invalid --metrics-set %q: must be Telemetry, SRE, or All", o.MetricsSet)
+	}
+	return nil
+}
+
+// oidcStorageBackend builds the storage.Backend selected by
+// OIDCStorageBackend from its backend-specific flags.
+func (o *Options) oidcStorageBackend() storage.Backend {
+	switch o.OIDCStorageBackend {
+	case "gcs":
+		return storage.GCSBackend{
+			Bucket:   o.OIDCGCSBucket,
+			Project:  o.OIDCGCSProject,
+			CredsKey: "credentials.json",
+		}
+	case "azureblob":
+		return storage.AzureBlobBackend{
+			StorageAccount: o.OIDCAzureStorageAccount,
+			Container:      o.OIDCAzureContainer,
+			UseMSI:         o.OIDCAzureUseMSI,
+			CredsKey:       "credentials",
+		}
+	case "filesystem":
+		return storage.FilesystemBackend{Path: o.OIDCFilesystemPath}
+	default:
+		return storage.S3Backend{
+			BucketName: o.OIDCBucketName,
+			Region:     o.OIDCBucketRegion,
+			RoleArn:    o.OIDCStorageProviderS3RoleArn,
+			CredsKey:   "credentials",
+		}
+	}
+}
+
+// oidcStorageCredentials reads and returns the credentials file for the
+// selected OIDC storage backend, or nil if the backend needs none.
+func (o *Options) oidcStorageCredentials() ([]byte, error) {
+	switch o.OIDCStorageBackend {
+	case "gcs":
+		return readCredentialsFile(o.OIDCGCSCredentials)
+	case "azureblob":
+		if o.OIDCAzureUseMSI {
+			return nil, nil
+		}
+		return readCredentialsFile(o.OIDCAzureCredentials)
+	case "filesystem":
+		return nil, nil
+	default:
+		if o.OIDCStorageProviderS3RoleArn != "" {
+			return nil, nil
+		}
+		return readCredentialsFile(o.OIDCStorageProviderS3Credentials)
+	}
+}
+
+// hasOIDCStorageConfig reports whether the user configured the selected OIDC
+// storage backend, so installs that don't publish OIDC documents at all
+// don't get a S3Backend wired in with every field empty.
+func (o *Options) hasOIDCStorageConfig() bool {
+	switch o.OIDCStorageBackend {
+	case "gcs":
+		return o.OIDCGCSBucket != ""
+	case "azureblob":
+		return o.OIDCAzureStorageAccount != ""
+	case "filesystem":
+		return o.OIDCFilesystemPath != ""
+	default:
+		return o.OIDCBucketName != ""
+	}
+}
+
+// Resources builds the full set of objects that make up a HyperShift
+// operator install, in apply order.
+func (o *Options) Resources() ([]runtime.Object, error) {
+	namespace := assets.HyperShiftNamespace{
+		Name:                       o.Namespace,
+		EnableOCPClusterMonitoring: o.EnableOCPClusterMonitoring,
+		EnableRestrictedSCC:        o.EnableRestrictedSCC,
+	}.Build()
+
+	operatorServiceAccount := assets.HyperShiftOperatorServiceAccount{Namespace: namespace}.Build()
+	operatorClusterRole := assets.HyperShiftOperatorClusterRole{
+		EnableRestrictedSCC: o.EnableRestrictedSCC,
+		Privileged:          o.Privileged,
+	}.Build()
+	operatorClusterRoleBinding := assets.HyperShiftOperatorClusterRoleBinding{
+		ClusterRole:    operatorClusterRole,
+		ServiceAccount: operatorServiceAccount,
+	}.Build()
+	operatorRole := assets.HyperShiftOperatorRole{Namespace: namespace}.Build()
+	operatorRoleBinding := assets.HyperShiftOperatorRoleBinding{
+		Role:           operatorRole,
+		ServiceAccount: operatorServiceAccount,
+	}.Build()
+	operatorDeploymentOpts := assets.HyperShiftOperatorDeployment{
+		Namespace:                  namespace,
+		OperatorImage:              o.OperatorImage,
+		ServiceAccount:             operatorServiceAccount,
+		Replicas:                   o.Replicas,
+		EnableOCPClusterMonitoring: o.EnableOCPClusterMonitoring,
+		EnableCIDebugOutput:        o.EnableCIDebugOutput,
+		EnableRestrictedSCC:        o.EnableRestrictedSCC,
+		Privileged:                 o.Privileged,
+		PrivatePlatform:            o.PrivatePlatform,
+		AWSPrivateCreds:            o.AWSPrivateCreds,
+		AWSPrivateRegion:           o.AWSPrivateRegion,
+		AWSPrivateRoleArn:          o.AWSPrivateRoleArn,
+		AWSPrivateSTSAudience:      o.AWSPrivateSTSAudience,
+	}
+
+	var oidcStorageCredsSecret *corev1.Secret
+	if o.hasOIDCStorageConfig() {
+		operatorDeploymentOpts.OIDCStorage = o.oidcStorageBackend()
+		credsBytes, err := o.oidcStorageCredentials()
+		if err != nil {
+			return nil, fmt.Errorf("invalid oidc storage backend credentials: %w", err)
+		}
+		if len(credsBytes) > 0 {
+			oidcStorageCredsSecret = assets.HyperShiftOperatorOIDCProviderS3Secret{
+				Namespace:                      namespace,
+				OIDCStorageProviderS3CredBytes: credsBytes,
+				CredsKey:                       operatorDeploymentOpts.OIDCStorage.CredentialsKey(),
+			}.Build()
+			operatorDeploymentOpts.OIDCStorageCredsSecret = oidcStorageCredsSecret
+		}
+	}
+
+	operatorDeployment := operatorDeploymentOpts.Build()
+	operatorService := assets.HyperShiftOperatorService{Namespace: namespace}.Build()
+
+	objects := []runtime.Object{
+		namespace,
+		operatorServiceAccount,
+		operatorClusterRole,
+		operatorClusterRoleBinding,
+		operatorRole,
+		operatorRoleBinding,
+		operatorDeployment,
+		operatorService,
+	}
+	if !o.EnableRestrictedSCC && !o.Privileged {
+		objects = append(objects, assets.HyperShiftRestrictedSCC{ServiceAccount: operatorServiceAccount}.Build())
+	}
+	if oidcStorageCredsSecret != nil {
+		objects = append(objects, oidcStorageCredsSecret)
+	}
+
+	if o.ExternalDNSProvider != "" {
+		externalDNSResources, err := o.externalDNSResources(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external-dns credentials: %w", err)
+		}
+		objects = append(objects, externalDNSResources...)
+	}
+
+	if !o.SkipPriorityClasses {
+		objects = append(objects,
+			assets.HyperShiftControlPlanePriorityClass{Config: assets.PriorityClassConfig{Value: o.ControlPlanePriority}}.Build(),
+			assets.HyperShiftAPICriticalPriorityClass{Config: assets.PriorityClassConfig{Value: o.APICriticalPriority}}.Build(),
+			assets.HyperShiftEtcdPriorityClass{Config: assets.PriorityClassConfig{Value: o.EtcdPriority}}.Build(),
+		)
+	}
+
+	objects = append(objects, o.readerAndClientResources(namespace)...)
+
+	if !o.DisableDefaultAlerts {
+		objects = append(objects,
+			assets.HypershiftRecordingRule{Namespace: namespace}.Build(),
+			assets.HypershiftAlertingRule{Namespace: namespace, MinSeverity: o.AlertSeverityFloor}.Build(),
+		)
+	}
+
+	objects = append(objects, o.metricsResources(namespace)...)
+
+	return objects, nil
+}
+
+// metricsResources builds the Role/RoleBinding that let the cluster's
+// Prometheus scrape the operator, plus the ServiceMonitor and PodMonitor
+// that point it at the operator's Service and every hosted control plane
+// namespace, scoped by MetricsSet.
+func (o *Options) metricsResources(namespace *corev1.Namespace) []runtime.Object {
+	prometheusRole := assets.HyperShiftPrometheusRole{Namespace: namespace}.Build()
+
+	crossNamespace := o.MetricsSet == "SRE" || o.MetricsSet == "All"
+
+	// Dropping the high-cardinality hosted_cluster label keeps a large
+	// hosted-cluster fleet from blowing up Prometheus's series count once
+	// scraping spans every hosted control plane namespace.
+	var metricRelabelings []*prometheusoperatorv1.RelabelConfig
+	if crossNamespace {
+		metricRelabelings = []*prometheusoperatorv1.RelabelConfig{
+			{Action: "labeldrop", Regex: "hosted_cluster"},
+		}
+	}
+
+	return []runtime.Object{
+		prometheusRole,
+		assets.HyperShiftOperatorPrometheusRoleBinding{
+			Namespace:                  namespace,
+			Role:                       prometheusRole,
+			EnableOCPClusterMonitoring: o.EnableOCPClusterMonitoring,
+		}.Build(),
+		assets.HyperShiftServiceMonitor{
+			Namespace:         namespace,
+			MetricsSet:        o.MetricsSet,
+			TLSConfig:         o.metricsTLSConfig(),
+			BearerTokenSecret: o.metricsBearerTokenSecret(),
+			HonorLabels:       crossNamespace,
+			MetricRelabelings: metricRelabelings,
+		}.Build(),
+		assets.HyperShiftPodMonitor{
+			Namespace:         namespace,
+			TLSConfig:         o.metricsTLSConfig(),
+			BearerTokenSecret: o.metricsBearerTokenSecret(),
+		}.Build(),
+	}
+}
+
+// metricsTLSConfig builds the TLSConfig that points the ServiceMonitor and
+// PodMonitor at MetricsTLSSecret's ca.crt/tls.crt/tls.key keys, or nil if
+// MetricsTLSSecret isn't set.
+func (o *Options) metricsTLSConfig() *prometheusoperatorv1.TLSConfig {
+	if o.MetricsTLSSecret == "" {
+		return nil
+	}
+	secretKey := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: o.MetricsTLSSecret},
+			Key:                  key,
+		}
+	}
+	return &prometheusoperatorv1.TLSConfig{
+		SafeTLSConfig: prometheusoperatorv1.SafeTLSConfig{
+			CA:        prometheusoperatorv1.SecretOrConfigMap{Secret: secretKey("ca.crt")},
+			Cert:      prometheusoperatorv1.SecretOrConfigMap{Secret: secretKey("tls.crt")},
+			KeySecret: secretKey("tls.key"),
+		},
+	}
+}
+
+// metricsBearerTokenSecret builds the SecretKeySelector pointing at
+// MetricsBearerTokenSecret/MetricsBearerTokenSecretKey, or nil if
+// MetricsBearerTokenSecret isn't set.
+func (o *Options) metricsBearerTokenSecret() *corev1.SecretKeySelector {
+	if o.MetricsBearerTokenSecret == "" {
+		return nil
+	}
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: o.MetricsBearerTokenSecret},
+		Key:                  o.MetricsBearerTokenSecretKey,
+	}
+}
+
+// readerAndClientResources builds the aggregated hypershift-readers
+// ClusterRole and its child ClusterRoles, the hypershift-client ClusterRole,
+// and the ClusterRoleBindings granting ReaderGroup/ClientGroup access.
+// Downstream operators can aggregate additional rules into hypershift-readers
+// without patching HyperShift; hypershift-client carries its own rules and
+// the aggregate-to-hypershift-client label, and has no AggregationRule, so
+// no rules are aggregated into it.
+func (o *Options) readerAndClientResources(namespace *corev1.Namespace) []runtime.Object {
+	readerClusterRole := assets.HyperShiftReaderClusterRole{}.Build()
+	objects := []runtime.Object{
+		readerClusterRole,
+		assets.HyperShiftReaderCoreClusterRole{}.Build(),
+		assets.HyperShiftReaderCAPIClusterRole{}.Build(),
+		assets.HyperShiftReaderOpenShiftConfigClusterRole{}.Build(),
+		assets.HyperShiftReaderMonitoringClusterRole{}.Build(),
+		assets.HyperShiftReaderAgentClusterRole{}.Build(),
+	}
+	if o.ReaderGroup != "" {
+		objects = append(objects, assets.HyperShiftReaderClusterRoleBinding{
+			ClusterRole: readerClusterRole,
+			GroupName:   o.ReaderGroup,
+		}.Build())
+	}
+
+	clientClusterRole := assets.HyperShiftClientClusterRole{}.Build()
+	clientServiceAccount := assets.HyperShiftClientServiceAccount{Namespace: namespace}.Build()
+	objects = append(objects, clientClusterRole, clientServiceAccount)
+	if o.ClientGroup != "" {
+		objects = append(objects, assets.HyperShiftClientClusterRoleBinding{
+			ClusterRole:    clientClusterRole,
+			ServiceAccount: clientServiceAccount,
+			GroupName:      o.ClientGroup,
+		}.Build())
+	}
+
+	return objects
+}
+
+// externalDNSResources builds the ServiceAccount, credentials Secret, and
+// Deployment for external-dns, using ExternalDNSProviderConfig to carry the
+// provider-specific tunables set via the --external-dns-* flags.
+func (o *Options) externalDNSResources(namespace *corev1.Namespace) ([]runtime.Object, error) {
+	serviceAccount := assets.ExternalDNSServiceAccount{Namespace: namespace}.Build()
+	clusterRole := assets.ExternalDNSClusterRole{}.Build()
+	clusterRoleBinding := assets.ExternalDNSClusterRoleBinding{
+		ClusterRole:    clusterRole,
+		ServiceAccount: serviceAccount,
+	}.Build()
+	credsBytes, err := readCredentialsFile(o.ExternalDNSCredentials)
+	if err != nil {
+		return nil, err
+	}
+	credsSecret := assets.ExternalDNSCredsSecret{
+		Namespace:  namespace,
+		CredsBytes: credsBytes,
+	}.Build()
+	deployment := assets.ExternalDNSDeployment{
+		Namespace:         namespace,
+		Image:             o.ExternalDNSImage,
+		ServiceAccount:    serviceAccount,
+		Provider:          o.ExternalDNSProvider,
+		DomainFilter:      o.ExternalDNSDomainFilter,
+		CredentialsSecret: credsSecret,
+		Registry:          o.ExternalDNSRegistry,
+		TXTOwnerID:        o.ExternalDNSTXTOwnerID,
+		Replicas:          o.ExternalDNSReplicas,
+		ProviderConfig: assets.ExternalDNSProviderConfig{
+			AzureResourceGroup: o.ExternalDNSAzureResourceGroup,
+			GCPProject:         o.ExternalDNSGCPProject,
+			AWSRoleArn:         o.ExternalDNSRoleArn,
+			AWSSTSAudience:     o.ExternalDNSSTSAudience,
+			ZoneType:           o.ExternalDNSZoneType,
+			TXTPrefix:          o.ExternalDNSTXTPrefix,
+			InfobloxGridHost:   o.ExternalDNSInfobloxGridHost,
+		},
+	}.Build()
+
+	return []runtime.Object{serviceAccount, clusterRole, clusterRoleBinding, credsSecret, deployment}, nil
+}
+
+func renderManifests(objects []runtime.Object, output string, out io.Writer) error {
+	for i, obj := range objects {
+		var raw []byte
+		var err error
+		switch output {
+		case "json":
+			raw, err = json.MarshalIndent(obj, "", "  ")
+		default:
+			raw, err = yaml.Marshal(obj)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		out.Write(raw)
+	}
+	return nil
+}
@@ -0,0 +1,732 @@
+package install
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	"github.com/openshift/hypershift/cmd/install/assets"
+	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// writeCredentialsFile writes content to a credentials file under the test's
+// TempDir and returns its path, since *Credentials flags are now read from
+// disk instead of taking their content literally.
+func writeCredentialsFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestExternalDNSDeploymentPerProvider(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "hypershift"}}
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "external-dns", Namespace: namespace.Name}}
+	credsSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "external-dns-credentials", Namespace: namespace.Name}}
+
+	tests := []struct {
+		provider       string
+		providerConfig assets.ExternalDNSProviderConfig
+		wantVolumes    bool
+		wantEnv        string
+		wantArg        string
+	}{
+		{
+			provider:       "aws",
+			providerConfig: assets.ExternalDNSProviderConfig{ZoneType: "public"},
+			wantVolumes:    true,
+			wantEnv:        "AWS_SHARED_CREDENTIALS_FILE",
+			wantArg:        "--aws-zone-type=public",
+		},
+		{
+			provider:       "azure",
+			providerConfig: assets.ExternalDNSProviderConfig{AzureResourceGroup: "my-rg"},
+			wantVolumes:    true,
+			wantArg:        "--azure-resource-group=my-rg",
+		},
+		{
+			provider:       "google",
+			providerConfig: assets.ExternalDNSProviderConfig{GCPProject: "my-project"},
+			wantVolumes:    true,
+			wantEnv:        "GOOGLE_APPLICATION_CREDENTIALS",
+			wantArg:        "--google-project=my-project",
+		},
+		{
+			provider:    "cloudflare",
+			wantVolumes: false,
+		},
+		{
+			provider:       "infoblox",
+			providerConfig: assets.ExternalDNSProviderConfig{InfobloxGridHost: "grid.example.com"},
+			wantVolumes:    true,
+			wantArg:        "--infoblox-grid-host=grid.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			deployment := assets.ExternalDNSDeployment{
+				Namespace:         namespace,
+				Image:             "external-dns:latest",
+				ServiceAccount:    serviceAccount,
+				Provider:          tt.provider,
+				ProviderConfig:    tt.providerConfig,
+				DomainFilter:      "example.com",
+				CredentialsSecret: credsSecret,
+			}.Build()
+
+			container := deployment.Spec.Template.Spec.Containers[0]
+
+			gotVolumes := len(container.VolumeMounts) > 0
+			if gotVolumes != tt.wantVolumes {
+				t.Errorf("provider %s: got volumes=%v, want %v", tt.provider, gotVolumes, tt.wantVolumes)
+			}
+
+			if tt.wantEnv != "" {
+				found := false
+				for _, env := range container.Env {
+					if env.Name == tt.wantEnv {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("provider %s: expected env var %s, got %+v", tt.provider, tt.wantEnv, container.Env)
+				}
+			}
+
+			if tt.wantArg != "" {
+				found := false
+				for _, arg := range container.Args {
+					if arg == tt.wantArg {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("provider %s: expected arg %s, got %v", tt.provider, tt.wantArg, container.Args)
+				}
+			}
+		})
+	}
+}
+
+func TestOptionsResourcesPerOIDCStorageBackend(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        func(t *testing.T) Options
+		wantArg     string
+		wantSecret  bool
+		wantNoCreds bool
+	}{
+		{
+			name: "s3",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:               "s3",
+					OIDCBucketName:                   "my-bucket",
+					OIDCBucketRegion:                 "us-east-1",
+					OIDCStorageProviderS3Credentials: writeCredentialsFile(t, "s3-creds", "creds"),
+				}
+			},
+			wantArg:    "--oidc-storage-backend=s3",
+			wantSecret: true,
+		},
+		{
+			name: "s3 with role arn has no credentials secret",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:           "s3",
+					OIDCBucketName:               "my-bucket",
+					OIDCBucketRegion:             "us-east-1",
+					OIDCStorageProviderS3RoleArn: "arn:aws:iam::123456789012:role/oidc",
+				}
+			},
+			wantArg:     "--oidc-storage-backend=s3",
+			wantNoCreds: true,
+		},
+		{
+			name: "gcs",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend: "gcs",
+					OIDCGCSBucket:      "my-bucket",
+					OIDCGCSProject:     "my-project",
+					OIDCGCSCredentials: writeCredentialsFile(t, "gcs-creds", "creds"),
+				}
+			},
+			wantArg:    "--oidc-storage-backend=gcs",
+			wantSecret: true,
+		},
+		{
+			name: "azureblob",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:      "azureblob",
+					OIDCAzureStorageAccount: "mystorageaccount",
+					OIDCAzureContainer:      "oidc",
+					OIDCAzureCredentials:    writeCredentialsFile(t, "azure-creds", "sastoken"),
+				}
+			},
+			wantArg:    "--oidc-storage-backend=azureblob",
+			wantSecret: true,
+		},
+		{
+			name: "azureblob with msi has no credentials secret",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:      "azureblob",
+					OIDCAzureStorageAccount: "mystorageaccount",
+					OIDCAzureContainer:      "oidc",
+					OIDCAzureUseMSI:         true,
+				}
+			},
+			wantArg:     "--oidc-storage-backend=azureblob",
+			wantNoCreds: true,
+		},
+		{
+			name: "filesystem",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend: "filesystem",
+					OIDCFilesystemPath: "/var/oidc",
+				}
+			},
+			wantArg:     "--oidc-storage-backend=filesystem",
+			wantNoCreds: true,
+		},
+		{
+			name: "unconfigured backend is omitted entirely",
+			opts: func(t *testing.T) Options {
+				return Options{OIDCStorageBackend: "s3"}
+			},
+			wantNoCreds: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := tt.opts(t).Resources()
+			if err != nil {
+				t.Fatalf("Resources() returned error: %v", err)
+			}
+
+			var deployment *appsv1.Deployment
+			secretCount := 0
+			for _, obj := range objects {
+				switch o := obj.(type) {
+				case *appsv1.Deployment:
+					if o.Name == "operator" {
+						deployment = o
+					}
+				case *corev1.Secret:
+					if o.Name == "hypershift-operator-oidc-provider-s3-credentials" {
+						secretCount++
+					}
+				}
+			}
+			if deployment == nil {
+				t.Fatalf("operator deployment not found in Resources()")
+			}
+
+			args := deployment.Spec.Template.Spec.Containers[0].Args
+			hasArg := false
+			for _, arg := range args {
+				if arg == tt.wantArg {
+					hasArg = true
+					break
+				}
+			}
+			if tt.wantArg != "" && !hasArg {
+				t.Errorf("expected arg %q, got %v", tt.wantArg, args)
+			}
+			if tt.wantArg == "" && hasArg {
+				t.Errorf("expected no oidc-storage-backend arg, got %v", args)
+			}
+
+			if tt.wantSecret && secretCount == 0 {
+				t.Errorf("expected an oidc storage credentials secret, found none")
+			}
+			if tt.wantNoCreds && secretCount != 0 {
+				t.Errorf("expected no oidc storage credentials secret, found %d", secretCount)
+			}
+		})
+	}
+}
+
+func TestOptionsResourcesReadsCredentialsFromDisk(t *testing.T) {
+	s3Path := writeCredentialsFile(t, "s3-creds", "aws-access-key-id = AKIAEXAMPLE")
+
+	objects, err := (&Options{
+		OIDCStorageBackend:               "s3",
+		OIDCBucketName:                   "my-bucket",
+		OIDCBucketRegion:                 "us-east-1",
+		OIDCStorageProviderS3Credentials: s3Path,
+	}).Resources()
+	if err != nil {
+		t.Fatalf("Resources() returned error: %v", err)
+	}
+
+	var secret *corev1.Secret
+	for _, obj := range objects {
+		if s, ok := obj.(*corev1.Secret); ok && s.Name == "hypershift-operator-oidc-provider-s3-credentials" {
+			secret = s
+		}
+	}
+	if secret == nil {
+		t.Fatalf("expected an oidc storage credentials secret, found none")
+	}
+	if got := string(secret.Data["credentials"]); got != "aws-access-key-id = AKIAEXAMPLE" {
+		t.Errorf("expected the secret to hold the credentials file's content, got %q (the --oidc-storage-provider-s3-credentials flag takes a path, not literal content)", got)
+	}
+}
+
+func TestOptionsValidateRejectsUnreadableCredentialsFile(t *testing.T) {
+	err := (&Options{AWSPrivateCreds: filepath.Join(t.TempDir(), "does-not-exist")}).Validate()
+	if err == nil {
+		t.Fatalf("expected an error for a missing credentials file, got nil")
+	}
+	if !strings.Contains(err.Error(), "invalid private-link AWS credentials") {
+		t.Errorf("expected error to mention private-link AWS credentials, got %q", err.Error())
+	}
+}
+
+func TestOptionsResourcesPriorityClasses(t *testing.T) {
+	zero := int32(0)
+	custom := int32(900)
+
+	t.Run("compiled-in defaults when not configured", func(t *testing.T) {
+		objects, err := (&Options{}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		for _, obj := range objects {
+			if pc, ok := obj.(*schedulingv1.PriorityClass); ok {
+				if pc.Value != 100000000 && pc.Value != 100001000 && pc.Value != 100002000 {
+					t.Errorf("unexpected priority class value %d for unconfigured install", pc.Value)
+				}
+			}
+		}
+	})
+
+	t.Run("skipped entirely with SkipPriorityClasses", func(t *testing.T) {
+		objects, err := (&Options{SkipPriorityClasses: true}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		for _, obj := range objects {
+			if _, ok := obj.(*schedulingv1.PriorityClass); ok {
+				t.Errorf("expected no PriorityClass objects, found %+v", obj)
+			}
+		}
+	})
+
+	t.Run("an explicit value of 0 is honored, not silently overridden", func(t *testing.T) {
+		objects, err := (&Options{ControlPlanePriority: &zero, APICriticalPriority: &custom}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawControlPlane, sawAPICritical bool
+		for _, obj := range objects {
+			pc, ok := obj.(*schedulingv1.PriorityClass)
+			if !ok {
+				continue
+			}
+			switch pc.Name {
+			case assets.DefaultPriorityClass:
+				sawControlPlane = true
+				if pc.Value != 0 {
+					t.Errorf("expected control-plane priority 0, got %d", pc.Value)
+				}
+			case assets.APICriticalPriorityClass:
+				sawAPICritical = true
+				if pc.Value != custom {
+					t.Errorf("expected api-critical priority %d, got %d", custom, pc.Value)
+				}
+			}
+		}
+		if !sawControlPlane || !sawAPICritical {
+			t.Fatalf("expected both control-plane and api-critical PriorityClasses, got %v", objects)
+		}
+	})
+}
+
+func TestOptionsResourcesPrivileged(t *testing.T) {
+	t.Run("privileged by default binds to the built-in SCC", func(t *testing.T) {
+		objects, err := (&Options{Privileged: true}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawRule bool
+		for _, obj := range objects {
+			if cr, ok := obj.(*rbacv1.ClusterRole); ok && cr.Name == "hypershift-operator" {
+				for _, rule := range cr.Rules {
+					if len(rule.ResourceNames) > 0 && rule.ResourceNames[0] == "privileged" {
+						sawRule = true
+					}
+				}
+			}
+			if _, ok := obj.(*securityv1.SecurityContextConstraints); ok {
+				t.Errorf("expected no hypershift-restricted SCC when Privileged=true")
+			}
+		}
+		if !sawRule {
+			t.Errorf("expected a PolicyRule granting use of the privileged SCC")
+		}
+	})
+
+	t.Run("non-privileged binds to hypershift-restricted and creates the SCC", func(t *testing.T) {
+		objects, err := (&Options{Privileged: false}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawRule, sawSCC bool
+		for _, obj := range objects {
+			if cr, ok := obj.(*rbacv1.ClusterRole); ok && cr.Name == "hypershift-operator" {
+				for _, rule := range cr.Rules {
+					if len(rule.ResourceNames) > 0 && rule.ResourceNames[0] == "hypershift-restricted" {
+						sawRule = true
+					}
+				}
+			}
+			if _, ok := obj.(*securityv1.SecurityContextConstraints); ok {
+				sawSCC = true
+			}
+		}
+		if !sawRule {
+			t.Errorf("expected a PolicyRule granting use of the hypershift-restricted SCC")
+		}
+		if !sawSCC {
+			t.Errorf("expected a hypershift-restricted SecurityContextConstraints object")
+		}
+	})
+}
+
+func TestOptionsResourcesReaderAndClientBindings(t *testing.T) {
+	countClusterRoles := func(objects []runtime.Object) int {
+		count := 0
+		for _, obj := range objects {
+			if _, ok := obj.(*rbacv1.ClusterRole); ok {
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("child ClusterRoles always present, bindings omitted without a group", func(t *testing.T) {
+		objects, err := (&Options{}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		for _, obj := range objects {
+			if b, ok := obj.(*rbacv1.ClusterRoleBinding); ok && (b.Name == "hypershift-readers" || b.Name == "hypershift-client") {
+				t.Errorf("expected no %s binding without a group configured", b.Name)
+			}
+		}
+		if countClusterRoles(objects) < 7 {
+			t.Errorf("expected the operator, reader, and client ClusterRoles to all be present, got %d", countClusterRoles(objects))
+		}
+	})
+
+	t.Run("bindings created when groups are configured", func(t *testing.T) {
+		objects, err := (&Options{ReaderGroup: "readers", ClientGroup: "clients"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawReaderBinding, sawClientBinding bool
+		for _, obj := range objects {
+			if b, ok := obj.(*rbacv1.ClusterRoleBinding); ok {
+				switch b.Name {
+				case "hypershift-readers":
+					sawReaderBinding = true
+				case "hypershift-client":
+					sawClientBinding = true
+				}
+			}
+		}
+		if !sawReaderBinding {
+			t.Errorf("expected a hypershift-readers ClusterRoleBinding")
+		}
+		if !sawClientBinding {
+			t.Errorf("expected a hypershift-client ClusterRoleBinding")
+		}
+	})
+}
+
+func TestOptionsResourcesMetricsSet(t *testing.T) {
+	t.Run("Telemetry scopes the ServiceMonitor to the operator's own namespace", func(t *testing.T) {
+		objects, err := (&Options{MetricsSet: "Telemetry"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawServiceMonitor, sawPodMonitor, sawRole, sawRoleBinding bool
+		for _, obj := range objects {
+			if sm, ok := obj.(*prometheusoperatorv1.ServiceMonitor); ok {
+				sawServiceMonitor = true
+				if sm.Spec.NamespaceSelector.Any {
+					t.Errorf("expected Telemetry to scope the ServiceMonitor to the operator namespace, got NamespaceSelector.Any")
+				}
+			}
+			if _, ok := obj.(*prometheusoperatorv1.PodMonitor); ok {
+				sawPodMonitor = true
+			}
+			if r, ok := obj.(*rbacv1.Role); ok && r.Name == "prometheus" {
+				sawRole = true
+			}
+			if _, ok := obj.(*rbacv1.RoleBinding); ok {
+				sawRoleBinding = true
+			}
+		}
+		if !sawServiceMonitor || !sawPodMonitor || !sawRole || !sawRoleBinding {
+			t.Errorf("expected a ServiceMonitor, PodMonitor, Role, and RoleBinding for prometheus scraping")
+		}
+	})
+
+	t.Run("SRE scopes the ServiceMonitor to every namespace", func(t *testing.T) {
+		objects, err := (&Options{MetricsSet: "SRE"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		var sawAnyNamespace bool
+		for _, obj := range objects {
+			if sm, ok := obj.(*prometheusoperatorv1.ServiceMonitor); ok && sm.Spec.NamespaceSelector.Any {
+				sawAnyNamespace = true
+			}
+		}
+		if !sawAnyNamespace {
+			t.Errorf("expected SRE to scope the ServiceMonitor to every namespace")
+		}
+	})
+}
+
+func TestOptionsResourcesMetricsSecurity(t *testing.T) {
+	serviceMonitorAndPodMonitor := func(objects []runtime.Object) (*prometheusoperatorv1.ServiceMonitor, *prometheusoperatorv1.PodMonitor) {
+		var sm *prometheusoperatorv1.ServiceMonitor
+		var pm *prometheusoperatorv1.PodMonitor
+		for _, obj := range objects {
+			switch o := obj.(type) {
+			case *prometheusoperatorv1.ServiceMonitor:
+				sm = o
+			case *prometheusoperatorv1.PodMonitor:
+				pm = o
+			}
+		}
+		return sm, pm
+	}
+
+	t.Run("no TLS or bearer token by default", func(t *testing.T) {
+		objects, err := (&Options{MetricsSet: "Telemetry"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		sm, pm := serviceMonitorAndPodMonitor(objects)
+		if sm == nil || pm == nil {
+			t.Fatalf("expected a ServiceMonitor and PodMonitor")
+		}
+		if sm.Spec.Endpoints[0].Scheme != "http" || pm.Spec.PodMetricsEndpoints[0].Scheme != "http" {
+			t.Errorf("expected plaintext HTTP scraping by default")
+		}
+	})
+
+	t.Run("metrics-tls-secret scrapes over HTTPS using its keys", func(t *testing.T) {
+		objects, err := (&Options{MetricsSet: "Telemetry", MetricsTLSSecret: "metrics-client-tls"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		sm, pm := serviceMonitorAndPodMonitor(objects)
+		if sm == nil || pm == nil {
+			t.Fatalf("expected a ServiceMonitor and PodMonitor")
+		}
+		if sm.Spec.Endpoints[0].Scheme != "https" || pm.Spec.PodMetricsEndpoints[0].Scheme != "https" {
+			t.Errorf("expected HTTPS scraping when --metrics-tls-secret is set")
+		}
+		tlsConfig := sm.Spec.Endpoints[0].TLSConfig
+		if tlsConfig == nil || tlsConfig.CA.Secret == nil || tlsConfig.CA.Secret.Name != "metrics-client-tls" || tlsConfig.CA.Secret.Key != "ca.crt" {
+			t.Errorf("expected the ServiceMonitor TLSConfig to read ca.crt from the metrics-tls-secret, got %+v", tlsConfig)
+		}
+	})
+
+	t.Run("metrics-bearer-token-secret authenticates the scrape", func(t *testing.T) {
+		objects, err := (&Options{
+			MetricsSet:                  "Telemetry",
+			MetricsBearerTokenSecret:    "metrics-scraper-token",
+			MetricsBearerTokenSecretKey: "token",
+		}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		sm, pm := serviceMonitorAndPodMonitor(objects)
+		if sm == nil || pm == nil {
+			t.Fatalf("expected a ServiceMonitor and PodMonitor")
+		}
+		if sm.Spec.Endpoints[0].BearerTokenSecret.Name != "metrics-scraper-token" || sm.Spec.Endpoints[0].BearerTokenSecret.Key != "token" {
+			t.Errorf("expected the ServiceMonitor to read its bearer token from metrics-scraper-token/token, got %+v", sm.Spec.Endpoints[0].BearerTokenSecret)
+		}
+		if pm.Spec.PodMetricsEndpoints[0].BearerTokenSecret.Name != "metrics-scraper-token" {
+			t.Errorf("expected the PodMonitor to read its bearer token from metrics-scraper-token, got %+v", pm.Spec.PodMetricsEndpoints[0].BearerTokenSecret)
+		}
+	})
+
+	t.Run("SRE drops the high-cardinality hosted_cluster label and honors target labels", func(t *testing.T) {
+		objects, err := (&Options{MetricsSet: "SRE"}).Resources()
+		if err != nil {
+			t.Fatalf("Resources() returned error: %v", err)
+		}
+		sm, _ := serviceMonitorAndPodMonitor(objects)
+		if sm == nil {
+			t.Fatalf("expected a ServiceMonitor")
+		}
+		if !sm.Spec.Endpoints[0].HonorLabels {
+			t.Errorf("expected HonorLabels when scraping spans namespaces")
+		}
+		var sawDropRule bool
+		for _, rc := range sm.Spec.Endpoints[0].MetricRelabelConfigs {
+			if rc.Action == "labeldrop" && rc.Regex == "hosted_cluster" {
+				sawDropRule = true
+			}
+		}
+		if !sawDropRule {
+			t.Errorf("expected a labeldrop rule for hosted_cluster, got %+v", sm.Spec.Endpoints[0].MetricRelabelConfigs)
+		}
+	})
+}
+
+func TestHypershiftAlertingRuleSeverityFloor(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "hypershift"}}
+
+	alertNames := func(rule *prometheusoperatorv1.PrometheusRule) []string {
+		var names []string
+		for _, group := range rule.Spec.Groups {
+			for _, r := range group.Rules {
+				names = append(names, r.Alert)
+			}
+		}
+		return names
+	}
+	contains := func(names []string, name string) bool {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("default floor keeps every alert", func(t *testing.T) {
+		rule := assets.HypershiftAlertingRule{Namespace: namespace}.Build()
+		names := alertNames(rule)
+		if !contains(names, "HostedClusterDegradedConditionTrue") {
+			t.Errorf("expected a warning-level alert to survive the default floor, got %v", names)
+		}
+		if !contains(names, "HypershiftOperatorDown") {
+			t.Errorf("expected a critical-level alert to survive the default floor, got %v", names)
+		}
+	})
+
+	t.Run("critical floor suppresses warning alerts instead of promoting them", func(t *testing.T) {
+		rule := assets.HypershiftAlertingRule{Namespace: namespace, MinSeverity: "critical"}.Build()
+		for _, group := range rule.Spec.Groups {
+			for _, r := range group.Rules {
+				if r.Labels["severity"] != "critical" {
+					t.Errorf("alert %s survived the critical floor with severity %q", r.Alert, r.Labels["severity"])
+				}
+			}
+		}
+		names := alertNames(rule)
+		if contains(names, "HostedClusterDegradedConditionTrue") {
+			t.Errorf("expected the warning-level HostedClusterDegradedConditionTrue alert to be dropped, got %v", names)
+		}
+		if !contains(names, "HypershiftOperatorDown") {
+			t.Errorf("expected the critical-level HypershiftOperatorDown alert to survive, got %v", names)
+		}
+	})
+}
+
+func TestOptionsValidateRejectsIncompatibleOIDCStorageFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    func(t *testing.T) Options
+		wantErr string
+	}{
+		{
+			name: "gcs credentials with s3 backend",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend: "s3",
+					OIDCGCSCredentials: "creds",
+				}
+			},
+			wantErr: "oidc storage backend s3 does not accept gcs or azure credentials",
+		},
+		{
+			name: "s3 credentials with gcs backend",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:               "gcs",
+					OIDCStorageProviderS3Credentials: writeCredentialsFile(t, "s3-creds", "creds"),
+				}
+			},
+			wantErr: "oidc storage backend gcs does not accept s3 or azure credentials",
+		},
+		{
+			name: "azure credentials with filesystem backend",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend:   "filesystem",
+					OIDCAzureCredentials: "sastoken",
+				}
+			},
+			wantErr: "oidc storage backend filesystem does not accept any credentials",
+		},
+		{
+			name: "unsupported backend",
+			opts: func(t *testing.T) Options {
+				return Options{OIDCStorageBackend: "bogus"}
+			},
+			wantErr: `unsupported oidc storage backend "bogus"`,
+		},
+		{
+			name: "both static credentials and role arn for private-link AWS",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend: "s3",
+					AWSPrivateCreds:    writeCredentialsFile(t, "aws-private-creds", "creds"),
+					AWSPrivateRoleArn:  "arn:aws:iam::123456789012:role/oidc",
+				}
+			},
+			wantErr: "invalid private-link AWS credentials",
+		},
+		{
+			name: "unsupported metrics set",
+			opts: func(t *testing.T) Options {
+				return Options{
+					OIDCStorageBackend: "s3",
+					MetricsSet:         "bogus",
+				}
+			},
+			wantErr: `invalid --metrics-set "bogus"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts(t).Validate()
+			if err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error to contain %q, got %q", tt.wantErr, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package spec
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/openshift/hypershift/cmd/infra/aws"
+	"github.com/openshift/hypershift/cmd/infra/azure"
+	"github.com/openshift/hypershift/cmd/infra/gcp"
+	"github.com/openshift/hypershift/cmd/infra/powervs"
+)
+
+// Destroyer is implemented by each provider's DestroyInfraOptions.
+type Destroyer interface {
+	Run(ctx context.Context) error
+}
+
+// NewDestroyer hydrates the provider-specific DestroyInfraOptions indicated
+// by spec.Platform from the fields in spec, so a single InfraSpec document
+// can drive `destroy infra` for any supported platform without assembling a
+// long CLI flag string.
+func NewDestroyer(s *InfraSpec, dryRun bool, output string) (Destroyer, error) {
+	logger, _ := zap.NewProduction(zap.AddCaller())
+	log := logger.Sugar()
+
+	switch s.Platform {
+	case "aws":
+		if s.AWS == nil {
+			return nil, fmt.Errorf("infra spec platform is aws but no aws section was provided")
+		}
+		return &aws.DestroyInfraOptions{
+			Region:             s.Region,
+			InfraID:            s.InfraID,
+			Name:               s.Name,
+			BaseDomain:         s.BaseDomain,
+			AWSCredentialsFile: s.AWS.CredentialsFile,
+			DryRun:             dryRun,
+			Output:             output,
+			Log:                log,
+		}, nil
+	case "azure":
+		if s.Azure == nil {
+			return nil, fmt.Errorf("infra spec platform is azure but no azure section was provided")
+		}
+		return &azure.DestroyInfraOptions{
+			Location:   s.Azure.Location,
+			InfraID:    s.InfraID,
+			Name:       s.Name,
+			BaseDomain: s.BaseDomain,
+			CredsFile:  s.Azure.CredentialsFile,
+			DryRun:     dryRun,
+			Output:     output,
+			Log:        log,
+		}, nil
+	case "gcp":
+		if s.GCP == nil {
+			return nil, fmt.Errorf("infra spec platform is gcp but no gcp section was provided")
+		}
+		return &gcp.DestroyInfraOptions{
+			Project:         s.GCP.Project,
+			Region:          s.Region,
+			InfraID:         s.InfraID,
+			Name:            s.Name,
+			BaseDomain:      s.BaseDomain,
+			CredentialsFile: s.GCP.CredentialsFile,
+			DryRun:          dryRun,
+			Output:          output,
+			Log:             log,
+		}, nil
+	case "powervs":
+		if s.PowerVS == nil {
+			return nil, fmt.Errorf("infra spec platform is powervs but no powervs section was provided")
+		}
+		return &powervs.DestroyInfraOptions{
+			Region:          s.Region,
+			Zone:            s.Zone,
+			InfraID:         s.InfraID,
+			Name:            s.Name,
+			BaseDomain:      s.BaseDomain,
+			ResourceGroup:   s.PowerVS.ResourceGroup,
+			CloudInstanceID: s.PowerVS.CloudInstanceID,
+			CISCRN:          s.PowerVS.CISCRN,
+			CredentialsFile: s.PowerVS.CredentialsFile,
+			DryRun:          dryRun,
+			Output:          output,
+			Log:             log,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported infra spec platform %q", s.Platform)
+	}
+}
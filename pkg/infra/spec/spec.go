@@ -0,0 +1,73 @@
+// Package spec defines a declarative, file-based description of a cluster's
+// cloud infrastructure that `hypershift destroy infra` consumes via
+// `--from-file`, so the artifact can be checked into git and drive teardown.
+// `hypershift create infra` does not read it.
+package spec
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// InfraSpec is the top-level document read from an InfraSpec file. Only the
+// section matching Platform needs to be populated.
+type InfraSpec struct {
+	Platform   string            `json:"platform"`
+	Region     string            `json:"region,omitempty"`
+	Zone       string            `json:"zone,omitempty"`
+	InfraID    string            `json:"infraID"`
+	Name       string            `json:"name,omitempty"`
+	BaseDomain string            `json:"baseDomain,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+
+	AWS     *AWSSpec     `json:"aws,omitempty"`
+	Azure   *AzureSpec   `json:"azure,omitempty"`
+	GCP     *GCPSpec     `json:"gcp,omitempty"`
+	PowerVS *PowerVSSpec `json:"powervs,omitempty"`
+}
+
+// AWSSpec carries the fields specific to the aws platform.
+type AWSSpec struct {
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+// AzureSpec carries the fields specific to the azure platform.
+type AzureSpec struct {
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	Location        string `json:"location,omitempty"`
+}
+
+// GCPSpec carries the fields specific to the gcp platform.
+type GCPSpec struct {
+	Project         string `json:"project,omitempty"`
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+// PowerVSSpec carries the fields specific to the powervs platform.
+type PowerVSSpec struct {
+	ResourceGroup   string `json:"resourceGroup,omitempty"`
+	CloudInstanceID string `json:"cloudInstanceID,omitempty"`
+	CISCRN          string `json:"cisCRN,omitempty"`
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+// Load reads and validates an InfraSpec document from a YAML or JSON file.
+func Load(path string) (*InfraSpec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read infra spec file %s: %w", path, err)
+	}
+	spec := &InfraSpec{}
+	if err := yaml.Unmarshal(raw, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse infra spec file %s: %w", path, err)
+	}
+	if spec.Platform == "" {
+		return nil, fmt.Errorf("infra spec file %s must set platform", path)
+	}
+	if spec.InfraID == "" {
+		return nil, fmt.Errorf("infra spec file %s must set infraID", path)
+	}
+	return spec, nil
+}
@@ -0,0 +1,166 @@
+// Package storage abstracts where the HyperShift operator publishes OIDC
+// discovery and JWKS documents, so HyperShift can be installed on GCP,
+// Azure, or fully air-gapped environments instead of requiring S3.
+package storage
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const credentialsMountPath = "/etc/oidc-storage-provider-creds"
+
+// Backend renders the operator CLI args, the key credentials should be
+// stored under in the generated Secret, and the pod Volume/VolumeMount
+// needed to publish OIDC discovery documents to a particular storage
+// location.
+type Backend interface {
+	// Kind identifies the backend for --oidc-storage-backend.
+	Kind() string
+	// Args returns the --oidc-storage-provider-* CLI args for the operator.
+	Args() []string
+	// CredentialsKey is the key credentials should be stored under in the
+	// generated Secret, or empty if the backend needs no credentials Secret
+	// (e.g. workload identity, or a PVC-backed filesystem path).
+	CredentialsKey() string
+}
+
+// Volume returns the pod Volume and VolumeMount that make a backend's
+// credentials Secret available to the operator container, or nil if the
+// backend has no CredentialsKey.
+func Volume(b Backend, secretName string) (*corev1.Volume, *corev1.VolumeMount) {
+	if b.CredentialsKey() == "" {
+		return nil, nil
+	}
+	return &corev1.Volume{
+			Name: "oidc-storage-provider-creds",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		}, &corev1.VolumeMount{
+			Name:      "oidc-storage-provider-creds",
+			MountPath: credentialsMountPath,
+		}
+}
+
+// S3Backend publishes OIDC documents to an AWS S3 bucket, optionally using
+// IRSA/STS instead of static credentials.
+type S3Backend struct {
+	BucketName string
+	Region     string
+	RoleArn    string
+	CredsKey   string
+}
+
+func (b S3Backend) Kind() string { return "s3" }
+
+func (b S3Backend) Args() []string {
+	args := []string{
+		fmt.Sprintf("--oidc-storage-provider-s3-bucket-name=%s", b.BucketName),
+		fmt.Sprintf("--oidc-storage-provider-s3-region=%s", b.Region),
+	}
+	if b.RoleArn == "" {
+		args = append(args, fmt.Sprintf("--oidc-storage-provider-s3-credentials=%s/%s", credentialsMountPath, b.CredsKey))
+	}
+	return args
+}
+
+func (b S3Backend) CredentialsKey() string {
+	if b.RoleArn != "" {
+		return ""
+	}
+	return b.CredsKey
+}
+
+// GCSBackend publishes OIDC documents to a Google Cloud Storage bucket using
+// a workload-identity service account JSON.
+type GCSBackend struct {
+	Bucket   string
+	Project  string
+	CredsKey string
+}
+
+func (b GCSBackend) Kind() string { return "gcs" }
+
+func (b GCSBackend) Args() []string {
+	return []string{
+		fmt.Sprintf("--oidc-storage-provider-gcs-bucket-name=%s", b.Bucket),
+		fmt.Sprintf("--oidc-storage-provider-gcs-project=%s", b.Project),
+		fmt.Sprintf("--oidc-storage-provider-gcs-credentials=%s/%s", credentialsMountPath, b.CredsKey),
+	}
+}
+
+func (b GCSBackend) CredentialsKey() string { return b.CredsKey }
+
+// AzureBlobBackend publishes OIDC documents to an Azure Blob Storage
+// container, authenticating with either a SAS token or the node's MSI.
+type AzureBlobBackend struct {
+	StorageAccount string
+	Container      string
+	UseMSI         bool
+	CredsKey       string
+}
+
+func (b AzureBlobBackend) Kind() string { return "azureblob" }
+
+func (b AzureBlobBackend) Args() []string {
+	args := []string{
+		fmt.Sprintf("--oidc-storage-provider-azure-storage-account=%s", b.StorageAccount),
+		fmt.Sprintf("--oidc-storage-provider-azure-container=%s", b.Container),
+	}
+	if !b.UseMSI {
+		args = append(args, fmt.Sprintf("--oidc-storage-provider-azure-credentials=%s/%s", credentialsMountPath, b.CredsKey))
+	}
+	return args
+}
+
+func (b AzureBlobBackend) CredentialsKey() string {
+	if b.UseMSI {
+		return ""
+	}
+	return b.CredsKey
+}
+
+// FilesystemBackend serves OIDC documents directly from Path, for fully
+// air-gapped installs with no object storage available. It adds no volume
+// for Path, so the rendered Deployment mounts no PVC there.
+type FilesystemBackend struct {
+	Path string
+}
+
+func (b FilesystemBackend) Kind() string { return "filesystem" }
+
+func (b FilesystemBackend) Args() []string {
+	return []string{fmt.Sprintf("--oidc-storage-provider-filesystem-path=%s", b.Path)}
+}
+
+func (b FilesystemBackend) CredentialsKey() string { return "" }
+
+// ValidateFlags rejects flag combinations that don't make sense for the
+// given backend kind, e.g. S3 credentials supplied for a gcs backend.
+func ValidateFlags(kind string, hasS3Creds, hasGCSCreds, hasAzureCreds bool) error {
+	switch kind {
+	case "s3":
+		if hasGCSCreds || hasAzureCreds {
+			return fmt.Errorf("oidc storage backend s3 does not accept gcs or azure credentials")
+		}
+	case "gcs":
+		if hasS3Creds || hasAzureCreds {
+			return fmt.Errorf("oidc storage backend gcs does not accept s3 or azure credentials")
+		}
+	case "azureblob":
+		if hasS3Creds || hasGCSCreds {
+			return fmt.Errorf("oidc storage backend azureblob does not accept s3 or gcs credentials")
+		}
+	case "filesystem":
+		if hasS3Creds || hasGCSCreds || hasAzureCreds {
+			return fmt.Errorf("oidc storage backend filesystem does not accept any credentials")
+		}
+	default:
+		return fmt.Errorf("unsupported oidc storage backend %q", kind)
+	}
+	return nil
+}